@@ -31,6 +31,7 @@ type ListChartsRequest struct {
 	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // 排序字段：name（默认，按名称升序）| updated（最近更新在前）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -93,15 +94,24 @@ func (x *ListChartsRequest) GetSize() int32 {
 	return 0
 }
 
+func (x *ListChartsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
 type ChartInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Chart 名称
-	ChartVersion  string                 `protobuf:"bytes,2,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"` // Chart 版本
+	ChartVersion  string                 `protobuf:"bytes,2,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"` // Chart 版本（最新的稳定版本）
 	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`                // 图标 URL
 	AppVersion    string                 `protobuf:"bytes,4,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`       // 应用版本
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                       // 应用描述
 	UpdateDate    string                 `protobuf:"bytes,6,opt,name=updateDate,proto3" json:"updateDate,omitempty"`                         // 更新时间
 	UpdateUser    string                 `protobuf:"bytes,7,opt,name=updateUser,proto3" json:"updateUser,omitempty"`                         // 更新者
+	Versions      []string               `protobuf:"bytes,8,rep,name=versions,proto3" json:"versions,omitempty"`                             // 所有版本，从高到低
+	Keywords      []string               `protobuf:"bytes,9,rep,name=keywords,proto3" json:"keywords,omitempty"`                             // 关键字
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,6 +195,20 @@ func (x *ChartInfo) GetUpdateUser() string {
 	return ""
 }
 
+func (x *ChartInfo) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ChartInfo) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
 type ListChartsData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -329,16 +353,18 @@ func (x *ListChartsResponse) GetData() *anypb.Any {
 	return nil
 }
 
-// 2. 配置私有仓库
+// 2. 配置私有仓库（不存在则新增，存在则更新）
 type ConfigureRepoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                   // 仓库名称
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                     // 仓库地址
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`           // 用户名（可选）
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`           // 密码（可选）
-	CaFile        string                 `protobuf:"bytes,5,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"` // CA 证书（可选）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                     // 仓库名称
+	Url                   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                                                       // 仓库地址
+	Username              string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                                                             // 用户名（可选）
+	Password              string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                                                             // 密码（可选）
+	CaFile                string                 `protobuf:"bytes,5,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`                                                   // CA 证书（可选），PEM 内容，或服务端 certs 目录、REPO_CA_DIR 下的文件路径
+	InsecureSkipTlsVerify bool                   `protobuf:"varint,6,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"` // 跳过 TLS 校验（可选）
+	PassCredentialsAll    bool                   `protobuf:"varint,7,opt,name=pass_credentials_all,json=passCredentialsAll,proto3" json:"pass_credentials_all,omitempty"`            // 向所有域名传递凭据（可选）
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ConfigureRepoRequest) Reset() {
@@ -406,9 +432,26 @@ func (x *ConfigureRepoRequest) GetCaFile() string {
 	return ""
 }
 
+func (x *ConfigureRepoRequest) GetInsecureSkipTlsVerify() bool {
+	if x != nil {
+		return x.InsecureSkipTlsVerify
+	}
+	return false
+}
+
+func (x *ConfigureRepoRequest) GetPassCredentialsAll() bool {
+	if x != nil {
+		return x.PassCredentialsAll
+	}
+	return false
+}
+
 type ConfigureRepoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *RepoInfo              `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -450,27 +493,53 @@ func (x *ConfigureRepoResponse) GetSuccess() bool {
 	return false
 }
 
-type K8SObject struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Object        *anypb.Any             `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"` // 存储任意 Kubernetes 对象
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConfigureRepoResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
 }
 
-func (x *K8SObject) Reset() {
-	*x = K8SObject{}
+func (x *ConfigureRepoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigureRepoResponse) GetData() *RepoInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RepoInfo struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url                   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Username              string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`           // 不返回密码
+	CaFile                string                 `protobuf:"bytes,4,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"` // 服务端保存的 CA 文件路径
+	InsecureSkipTlsVerify bool                   `protobuf:"varint,5,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
+	PassCredentialsAll    bool                   `protobuf:"varint,6,opt,name=pass_credentials_all,json=passCredentialsAll,proto3" json:"pass_credentials_all,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RepoInfo) Reset() {
+	*x = RepoInfo{}
 	mi := &file_helm_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *K8SObject) String() string {
+func (x *RepoInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SObject) ProtoMessage() {}
+func (*RepoInfo) ProtoMessage() {}
 
-func (x *K8SObject) ProtoReflect() protoreflect.Message {
+func (x *RepoInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,39 +551,73 @@ func (x *K8SObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SObject.ProtoReflect.Descriptor instead.
-func (*K8SObject) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
+func (*RepoInfo) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *K8SObject) GetObject() *anypb.Any {
+func (x *RepoInfo) GetName() string {
 	if x != nil {
-		return x.Object
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type K8SObjectList struct {
+func (x *RepoInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetInsecureSkipTlsVerify() bool {
+	if x != nil {
+		return x.InsecureSkipTlsVerify
+	}
+	return false
+}
+
+func (x *RepoInfo) GetPassCredentialsAll() bool {
+	if x != nil {
+		return x.PassCredentialsAll
+	}
+	return false
+}
+
+type ListReposRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*K8SObject           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 相当于 []runtime.Object
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *K8SObjectList) Reset() {
-	*x = K8SObjectList{}
+func (x *ListReposRequest) Reset() {
+	*x = ListReposRequest{}
 	mi := &file_helm_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *K8SObjectList) String() string {
+func (x *ListReposRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*K8SObjectList) ProtoMessage() {}
+func (*ListReposRequest) ProtoMessage() {}
 
-func (x *K8SObjectList) ProtoReflect() protoreflect.Message {
+func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,46 +629,35 @@ func (x *K8SObjectList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use K8SObjectList.ProtoReflect.Descriptor instead.
-func (*K8SObjectList) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListReposRequest.ProtoReflect.Descriptor instead.
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *K8SObjectList) GetItems() []*K8SObject {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-// 3. 安装 Chart
-type InstallChartRequest struct {
+type ListReposResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Chart 名称
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // 安装的名称
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                            // Chart 版本
-	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // 目标命名空间
-	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // 检查chart文件是否合法
-	Values        string                 `protobuf:"bytes,6,opt,name=values,proto3" json:"values,omitempty"`                              // values.yaml 内容（JSON/YAML 字符串）
-	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          []*RepoInfo            `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallChartRequest) Reset() {
-	*x = InstallChartRequest{}
+func (x *ListReposResponse) Reset() {
+	*x = ListReposResponse{}
 	mi := &file_helm_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallChartRequest) String() string {
+func (x *ListReposResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallChartRequest) ProtoMessage() {}
+func (*ListReposResponse) ProtoMessage() {}
 
-func (x *InstallChartRequest) ProtoReflect() protoreflect.Message {
+func (x *ListReposResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,88 +669,60 @@ func (x *InstallChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallChartRequest.ProtoReflect.Descriptor instead.
-func (*InstallChartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListReposResponse.ProtoReflect.Descriptor instead.
+func (*ListReposResponse) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InstallChartRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *InstallChartRequest) GetReleaseName() string {
-	if x != nil {
-		return x.ReleaseName
-	}
-	return ""
-}
-
-func (x *InstallChartRequest) GetVersion() string {
+func (x *ListReposResponse) GetCode() int32 {
 	if x != nil {
-		return x.Version
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *InstallChartRequest) GetNamespace() string {
+func (x *ListReposResponse) GetMessage() string {
 	if x != nil {
-		return x.Namespace
+		return x.Message
 	}
 	return ""
 }
 
-func (x *InstallChartRequest) GetDryRun() bool {
+func (x *ListReposResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DryRun
+		return x.Success
 	}
 	return false
 }
 
-func (x *InstallChartRequest) GetValues() string {
-	if x != nil {
-		return x.Values
-	}
-	return ""
-}
-
-func (x *InstallChartRequest) GetUserId() string {
+func (x *ListReposResponse) GetData() []*RepoInfo {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type InstallChartResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Code          int32                     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	ReleaseName   string                    `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // 安装名称
-	FirstDeployed string                    `protobuf:"bytes,3,opt,name=first_deployed,json=firstDeployed,proto3" json:"first_deployed,omitempty"`
-	LastDeployed  string                    `protobuf:"bytes,4,opt,name=last_deployed,json=lastDeployed,proto3" json:"last_deployed,omitempty"`
-	Deleted       string                    `protobuf:"bytes,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	Message       string                    `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	Status        string                    `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Entries       map[string]*K8SObjectList `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type RemoveRepoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 仓库名称
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallChartResponse) Reset() {
-	*x = InstallChartResponse{}
+func (x *RemoveRepoRequest) Reset() {
+	*x = RemoveRepoRequest{}
 	mi := &file_helm_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallChartResponse) String() string {
+func (x *RemoveRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallChartResponse) ProtoMessage() {}
+func (*RemoveRepoRequest) ProtoMessage() {}
 
-func (x *InstallChartResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveRepoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,93 +734,101 @@ func (x *InstallChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallChartResponse.ProtoReflect.Descriptor instead.
-func (*InstallChartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveRepoRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *InstallChartResponse) GetCode() int32 {
+func (x *RemoveRepoRequest) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *InstallChartResponse) GetReleaseName() string {
-	if x != nil {
-		return x.ReleaseName
-	}
-	return ""
+type RemoveRepoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallChartResponse) GetFirstDeployed() string {
-	if x != nil {
-		return x.FirstDeployed
-	}
-	return ""
+func (x *RemoveRepoResponse) Reset() {
+	*x = RemoveRepoResponse{}
+	mi := &file_helm_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallChartResponse) GetLastDeployed() string {
-	if x != nil {
-		return x.LastDeployed
-	}
-	return ""
+func (x *RemoveRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InstallChartResponse) GetDeleted() string {
+func (*RemoveRepoResponse) ProtoMessage() {}
+
+func (x *RemoveRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[10]
 	if x != nil {
-		return x.Deleted
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InstallChartResponse) GetMessage() string {
+// Deprecated: Use RemoveRepoResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRepoResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveRepoResponse) GetCode() int32 {
 	if x != nil {
-		return x.Message
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *InstallChartResponse) GetStatus() string {
+func (x *RemoveRepoResponse) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
 	return ""
 }
 
-func (x *InstallChartResponse) GetEntries() map[string]*K8SObjectList {
+func (x *RemoveRepoResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Entries
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// 4. 卸载请求参数
-type UninstallChartRequest struct {
+type ListChartTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                       // 命名空间（从 URL 路径获取）
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`                                                // Chart 名称（从 URL 路径获取）
-	Purge         bool                   `protobuf:"varint,3,opt,name=purge,proto3" json:"purge,omitempty"`                                                                              // 是否彻底删除（从 Body 或 Query 获取）
-	Options       map[string]string      `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 其他卸载选项（如超时时间）
+	RepoName      string                 `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`    // OCI 仓库名称
+	ChartName     string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"` // Chart 名称
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UninstallChartRequest) Reset() {
-	*x = UninstallChartRequest{}
-	mi := &file_helm_service_proto_msgTypes[10]
+func (x *ListChartTagsRequest) Reset() {
+	*x = ListChartTagsRequest{}
+	mi := &file_helm_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UninstallChartRequest) String() string {
+func (x *ListChartTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UninstallChartRequest) ProtoMessage() {}
+func (*ListChartTagsRequest) ProtoMessage() {}
 
-func (x *UninstallChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[10]
+func (x *ListChartTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,63 +839,50 @@ func (x *UninstallChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UninstallChartRequest.ProtoReflect.Descriptor instead.
-func (*UninstallChartRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListChartTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListChartTagsRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UninstallChartRequest) GetNamespace() string {
+func (x *ListChartTagsRequest) GetRepoName() string {
 	if x != nil {
-		return x.Namespace
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *UninstallChartRequest) GetReleaseName() string {
+func (x *ListChartTagsRequest) GetChartName() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.ChartName
 	}
 	return ""
 }
 
-func (x *UninstallChartRequest) GetPurge() bool {
-	if x != nil {
-		return x.Purge
-	}
-	return false
-}
-
-func (x *UninstallChartRequest) GetOptions() map[string]string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-// 4. 卸载响应
-type UninstallChartResponse struct {
+type ListChartTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 详细信息（如错误原因）
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` // 按 semver 从高到低排序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UninstallChartResponse) Reset() {
-	*x = UninstallChartResponse{}
-	mi := &file_helm_service_proto_msgTypes[11]
+func (x *ListChartTagsResponse) Reset() {
+	*x = ListChartTagsResponse{}
+	mi := &file_helm_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UninstallChartResponse) String() string {
+func (x *ListChartTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UninstallChartResponse) ProtoMessage() {}
+func (*ListChartTagsResponse) ProtoMessage() {}
 
-func (x *UninstallChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[11]
+func (x *ListChartTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,100 +893,62 @@ func (x *UninstallChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UninstallChartResponse.ProtoReflect.Descriptor instead.
-func (*UninstallChartResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListChartTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListChartTagsResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UninstallChartResponse) GetCode() int32 {
+func (x *ListChartTagsResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *UninstallChartResponse) GetMessage() string {
+func (x *ListChartTagsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 5. 监控安装状态
-type WatchInstallStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReleaseName   string                 `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // 命名空间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WatchInstallStatusRequest) Reset() {
-	*x = WatchInstallStatusRequest{}
-	mi := &file_helm_service_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WatchInstallStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WatchInstallStatusRequest) ProtoMessage() {}
-
-func (x *WatchInstallStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WatchInstallStatusRequest.ProtoReflect.Descriptor instead.
-func (*WatchInstallStatusRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *WatchInstallStatusRequest) GetReleaseName() string {
+func (x *ListChartTagsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ReleaseName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *WatchInstallStatusRequest) GetNamespace() string {
+func (x *ListChartTagsResponse) GetTags() []string {
 	if x != nil {
-		return x.Namespace
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type InstallStatus struct {
+type GetChartDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`     // Installing | Deployed | Failed
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 状态详情
+	RepoName      string                 `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`    // 仓库名称（空表示默认仓库）
+	ChartName     string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"` // Chart 名称，也可以是完整的 oci:// 引用
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                      // Chart 版本（空表示最新的稳定版本）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallStatus) Reset() {
-	*x = InstallStatus{}
+func (x *GetChartDetailsRequest) Reset() {
+	*x = GetChartDetailsRequest{}
 	mi := &file_helm_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallStatus) String() string {
+func (x *GetChartDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallStatus) ProtoMessage() {}
+func (*GetChartDetailsRequest) ProtoMessage() {}
 
-func (x *InstallStatus) ProtoReflect() protoreflect.Message {
+func (x *GetChartDetailsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,48 +960,55 @@ func (x *InstallStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallStatus.ProtoReflect.Descriptor instead.
-func (*InstallStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChartDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetChartDetailsRequest) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *InstallStatus) GetPhase() string {
+func (x *GetChartDetailsRequest) GetRepoName() string {
 	if x != nil {
-		return x.Phase
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *InstallStatus) GetMessage() string {
+func (x *GetChartDetailsRequest) GetChartName() string {
 	if x != nil {
-		return x.Message
+		return x.ChartName
 	}
 	return ""
 }
 
-// 6. 监控 Pod 状态
-type ListPodStatusRequest struct {
+func (x *GetChartDetailsRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ChartMaintainer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // 目标命名空间
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // Helm Release 名称
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPodStatusRequest) Reset() {
-	*x = ListPodStatusRequest{}
+func (x *ChartMaintainer) Reset() {
+	*x = ChartMaintainer{}
 	mi := &file_helm_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPodStatusRequest) String() string {
+func (x *ChartMaintainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPodStatusRequest) ProtoMessage() {}
+func (*ChartMaintainer) ProtoMessage() {}
 
-func (x *ListPodStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ChartMaintainer) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,55 +1020,59 @@ func (x *ListPodStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPodStatusRequest.ProtoReflect.Descriptor instead.
-func (*ListPodStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChartMaintainer.ProtoReflect.Descriptor instead.
+func (*ChartMaintainer) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListPodStatusRequest) GetNamespace() string {
+func (x *ChartMaintainer) GetName() string {
 	if x != nil {
-		return x.Namespace
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListPodStatusRequest) GetReleaseName() string {
+func (x *ChartMaintainer) GetEmail() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.Email
 	}
 	return ""
 }
 
-type PodStatus struct {
+func (x *ChartMaintainer) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ChartDependency struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // Pod 名称
-	Phase         string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"` // 当前阶段（Pending/Running/Succeeded/Failed）
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Ready         string                 `protobuf:"bytes,4,opt,name=ready,proto3" json:"ready,omitempty"`        // 就绪状态（如 "1/2"）
-	Restarts      int32                  `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"` // 容器重启次数
-	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`              // Pod IP 地址
-	Node          string                 `protobuf:"bytes,7,opt,name=node,proto3" json:"node,omitempty"`          // 所在节点名称
-	Age           string                 `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Pod 标签
-	Containers    []*ContainerStatus     `protobuf:"bytes,10,rep,name=containers,proto3" json:"containers,omitempty"`                                                                  // 容器状态详情
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 版本约束
+	Repository    string                 `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	Condition     string                 `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Alias         string                 `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	Vendored      bool                   `protobuf:"varint,7,opt,name=vendored,proto3" json:"vendored,omitempty"` // 是否已打包在 charts/ 目录中
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PodStatus) Reset() {
-	*x = PodStatus{}
+func (x *ChartDependency) Reset() {
+	*x = ChartDependency{}
 	mi := &file_helm_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PodStatus) String() string {
+func (x *ChartDependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PodStatus) ProtoMessage() {}
+func (*ChartDependency) ProtoMessage() {}
 
-func (x *PodStatus) ProtoReflect() protoreflect.Message {
+func (x *ChartDependency) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,106 +1084,99 @@ func (x *PodStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PodStatus.ProtoReflect.Descriptor instead.
-func (*PodStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChartDependency.ProtoReflect.Descriptor instead.
+func (*ChartDependency) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PodStatus) GetName() string {
+func (x *ChartDependency) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PodStatus) GetPhase() string {
-	if x != nil {
-		return x.Phase
-	}
-	return ""
-}
-
-func (x *PodStatus) GetStatus() string {
+func (x *ChartDependency) GetVersion() string {
 	if x != nil {
-		return x.Status
+		return x.Version
 	}
 	return ""
 }
 
-func (x *PodStatus) GetReady() string {
+func (x *ChartDependency) GetRepository() string {
 	if x != nil {
-		return x.Ready
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *PodStatus) GetRestarts() int32 {
-	if x != nil {
-		return x.Restarts
-	}
-	return 0
-}
-
-func (x *PodStatus) GetIp() string {
+func (x *ChartDependency) GetCondition() string {
 	if x != nil {
-		return x.Ip
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *PodStatus) GetNode() string {
+func (x *ChartDependency) GetTags() []string {
 	if x != nil {
-		return x.Node
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *PodStatus) GetAge() string {
+func (x *ChartDependency) GetAlias() string {
 	if x != nil {
-		return x.Age
+		return x.Alias
 	}
 	return ""
 }
 
-func (x *PodStatus) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-func (x *PodStatus) GetContainers() []*ContainerStatus {
+func (x *ChartDependency) GetVendored() bool {
 	if x != nil {
-		return x.Containers
+		return x.Vendored
 	}
-	return nil
+	return false
 }
 
-type ContainerStatus struct {
+type ChartDetails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ready         bool                   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
-	RestartCount  int32                  `protobuf:"varint,3,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // waiting/running/terminated
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ApiVersion    string                 `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`    // Chart.yaml 的 apiVersion（v1/v2）
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`                                  // application | library
+	KubeVersion   string                 `protobuf:"bytes,7,opt,name=kube_version,json=kubeVersion,proto3" json:"kube_version,omitempty"` // 支持的 Kubernetes 版本约束
+	Home          string                 `protobuf:"bytes,8,opt,name=home,proto3" json:"home,omitempty"`
+	Icon          string                 `protobuf:"bytes,9,opt,name=icon,proto3" json:"icon,omitempty"`
+	Keywords      []string               `protobuf:"bytes,10,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Sources       []string               `protobuf:"bytes,11,rep,name=sources,proto3" json:"sources,omitempty"`
+	Maintainers   []*ChartMaintainer     `protobuf:"bytes,12,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	Deprecated    bool                   `protobuf:"varint,13,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	Annotations   map[string]string      `protobuf:"bytes,14,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Values        string                 `protobuf:"bytes,15,opt,name=values,proto3" json:"values,omitempty"`                                 // 默认 values.yaml 原文
+	ValuesSchema  string                 `protobuf:"bytes,16,opt,name=values_schema,json=valuesSchema,proto3" json:"values_schema,omitempty"` // values.schema.json 原文（没有时为空）
+	Readme        string                 `protobuf:"bytes,17,opt,name=readme,proto3" json:"readme,omitempty"`                                 // README.md 原文（没有时为空）
+	Dependencies  []*ChartDependency     `protobuf:"bytes,18,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Digest        string                 `protobuf:"bytes,19,opt,name=digest,proto3" json:"digest,omitempty"` // chart 包的 sha256
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerStatus) Reset() {
-	*x = ContainerStatus{}
+func (x *ChartDetails) Reset() {
+	*x = ChartDetails{}
 	mi := &file_helm_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStatus) String() string {
+func (x *ChartDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStatus) ProtoMessage() {}
+func (*ChartDetails) ProtoMessage() {}
 
-func (x *ContainerStatus) ProtoReflect() protoreflect.Message {
+func (x *ChartDetails) ProtoReflect() protoreflect.Message {
 	mi := &file_helm_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1163,115 +1188,3906 @@ func (x *ContainerStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStatus.ProtoReflect.Descriptor instead.
-func (*ContainerStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChartDetails.ProtoReflect.Descriptor instead.
+func (*ChartDetails) Descriptor() ([]byte, []int) {
 	return file_helm_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ContainerStatus) GetName() string {
+func (x *ChartDetails) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerStatus) GetReady() bool {
+func (x *ChartDetails) GetVersion() string {
 	if x != nil {
-		return x.Ready
+		return x.Version
 	}
-	return false
+	return ""
 }
 
-func (x *ContainerStatus) GetRestartCount() int32 {
+func (x *ChartDetails) GetAppVersion() string {
 	if x != nil {
-		return x.RestartCount
+		return x.AppVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerStatus) GetState() string {
+func (x *ChartDetails) GetDescription() string {
 	if x != nil {
-		return x.State
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ContainerStatus) GetImage() string {
+func (x *ChartDetails) GetApiVersion() string {
 	if x != nil {
-		return x.Image
+		return x.ApiVersion
 	}
 	return ""
 }
 
-type PodsStatusList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pods          []*PodStatus           `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"` // Pod 状态数组
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ChartDetails) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *PodsStatusList) Reset() {
-	*x = PodsStatusList{}
-	mi := &file_helm_service_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ChartDetails) GetKubeVersion() string {
+	if x != nil {
+		return x.KubeVersion
+	}
+	return ""
 }
 
-func (x *PodsStatusList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChartDetails) GetHome() string {
+	if x != nil {
+		return x.Home
+	}
+	return ""
 }
 
-func (*PodsStatusList) ProtoMessage() {}
+func (x *ChartDetails) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
 
-func (x *PodsStatusList) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[17]
+func (x *ChartDetails) GetKeywords() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Keywords
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PodsStatusList.ProtoReflect.Descriptor instead.
-func (*PodsStatusList) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{17}
+func (x *ChartDetails) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
 }
 
-func (x *PodsStatusList) GetPods() []*PodStatus {
+func (x *ChartDetails) GetMaintainers() []*ChartMaintainer {
 	if x != nil {
-		return x.Pods
+		return x.Maintainers
 	}
 	return nil
 }
 
-type ListPodStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *ChartDetails) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *ChartDetails) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ChartDetails) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *ChartDetails) GetValuesSchema() string {
+	if x != nil {
+		return x.ValuesSchema
+	}
+	return ""
+}
+
+func (x *ChartDetails) GetReadme() string {
+	if x != nil {
+		return x.Readme
+	}
+	return ""
+}
+
+func (x *ChartDetails) GetDependencies() []*ChartDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *ChartDetails) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type GetChartDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *ChartDetails          `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartDetailsResponse) Reset() {
+	*x = GetChartDetailsResponse{}
+	mi := &file_helm_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartDetailsResponse) ProtoMessage() {}
+
+func (x *GetChartDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetChartDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetChartDetailsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetChartDetailsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetChartDetailsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetChartDetailsResponse) GetData() *ChartDetails {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type K8SObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *anypb.Any             `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"` // 存储任意 Kubernetes 对象
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *K8SObject) Reset() {
+	*x = K8SObject{}
+	mi := &file_helm_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *K8SObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SObject) ProtoMessage() {}
+
+func (x *K8SObject) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SObject.ProtoReflect.Descriptor instead.
+func (*K8SObject) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *K8SObject) GetObject() *anypb.Any {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type K8SObjectList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*K8SObject           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 相当于 []runtime.Object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *K8SObjectList) Reset() {
+	*x = K8SObjectList{}
+	mi := &file_helm_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *K8SObjectList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SObjectList) ProtoMessage() {}
+
+func (x *K8SObjectList) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SObjectList.ProtoReflect.Descriptor instead.
+func (*K8SObjectList) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *K8SObjectList) GetItems() []*K8SObject {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 3. 安装 Chart
+type InstallChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Chart 名称，也可以是 oci:// 引用
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // 安装的名称
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                            // Chart 版本
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // 目标命名空间
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // 检查chart文件是否合法
+	Values        string                 `protobuf:"bytes,6,opt,name=values,proto3" json:"values,omitempty"`                              // values.yaml 内容（JSON/YAML 字符串）
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 已废弃，操作人取自调用方 token
+	RepoName      string                 `protobuf:"bytes,8,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`          // 仓库名称（可选，默认 harbor）
+	Scope         *WorkspaceScope        `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`                                // 按工作空间安装（可选），目标为对应的托管命名空间，不存在时自动创建
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallChartRequest) Reset() {
+	*x = InstallChartRequest{}
+	mi := &file_helm_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallChartRequest) ProtoMessage() {}
+
+func (x *InstallChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallChartRequest.ProtoReflect.Descriptor instead.
+func (*InstallChartRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InstallChartRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *InstallChartRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *InstallChartRequest) GetScope() *WorkspaceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type InstallChartResponse struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Code             int32                      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	ReleaseName      string                     `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // 安装名称
+	FirstDeployed    string                     `protobuf:"bytes,3,opt,name=first_deployed,json=firstDeployed,proto3" json:"first_deployed,omitempty"`
+	LastDeployed     string                     `protobuf:"bytes,4,opt,name=last_deployed,json=lastDeployed,proto3" json:"last_deployed,omitempty"`
+	Deleted          string                     `protobuf:"bytes,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Message          string                     `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Status           string                     `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Entries          map[string]*K8SObjectList  `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // dry run 渲染出的资源，按 Kind 分组
+	OperationId      string                     `protobuf:"bytes,9,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`                                                // 异步操作 ID，通过 GetOperation 查询进度
+	Notes            string                     `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`                                                                              // dry run 渲染出的 NOTES.txt
+	ValidationErrors []*ResourceValidationError `protobuf:"bytes,11,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`                                // dry run 中未通过校验的资源
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InstallChartResponse) Reset() {
+	*x = InstallChartResponse{}
+	mi := &file_helm_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallChartResponse) ProtoMessage() {}
+
+func (x *InstallChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallChartResponse.ProtoReflect.Descriptor instead.
+func (*InstallChartResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InstallChartResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *InstallChartResponse) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetFirstDeployed() string {
+	if x != nil {
+		return x.FirstDeployed
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetLastDeployed() string {
+	if x != nil {
+		return x.LastDeployed
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetDeleted() string {
+	if x != nil {
+		return x.Deleted
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetEntries() map[string]*K8SObjectList {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *InstallChartResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *InstallChartResponse) GetValidationErrors() []*ResourceValidationError {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+type ResourceValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // 生成该资源的模板（如 mychart/templates/deployment.yaml）
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceValidationError) Reset() {
+	*x = ResourceValidationError{}
+	mi := &file_helm_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceValidationError) ProtoMessage() {}
+
+func (x *ResourceValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceValidationError.ProtoReflect.Descriptor instead.
+func (*ResourceValidationError) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResourceValidationError) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceValidationError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceValidationError) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceValidationError) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ResourceValidationError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 4. 卸载请求参数
+type UninstallChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                       // 命名空间（从 URL 路径获取）
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`                                                // Chart 名称（从 URL 路径获取）
+	Purge         bool                   `protobuf:"varint,3,opt,name=purge,proto3" json:"purge,omitempty"`                                                                              // 是否彻底删除（从 Body 或 Query 获取）
+	Options       map[string]string      `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 其他卸载选项（如超时时间 timeout: "5m"）
+	KeepHistory   bool                   `protobuf:"varint,5,opt,name=keep_history,json=keepHistory,proto3" json:"keep_history,omitempty"`                                               // 保留 release 历史（--keep-history），之后仍可回滚
+	DeletePvcs    bool                   `protobuf:"varint,6,opt,name=delete_pvcs,json=deletePvcs,proto3" json:"delete_pvcs,omitempty"`                                                  // 同时删除 release 的 PVC，默认保留数据
+	CleanupKinds  []string               `protobuf:"bytes,7,rep,name=cleanup_kinds,json=cleanupKinds,proto3" json:"cleanup_kinds,omitempty"`                                             // 卸载后需要清理的资源类型（默认全部支持的类型，PVC 还需 delete_pvcs）
+	SkipCleanup   bool                   `protobuf:"varint,8,opt,name=skip_cleanup,json=skipCleanup,proto3" json:"skip_cleanup,omitempty"`                                               // 只执行 helm uninstall，不清理残留资源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UninstallChartRequest) Reset() {
+	*x = UninstallChartRequest{}
+	mi := &file_helm_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UninstallChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallChartRequest) ProtoMessage() {}
+
+func (x *UninstallChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallChartRequest.ProtoReflect.Descriptor instead.
+func (*UninstallChartRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UninstallChartRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UninstallChartRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *UninstallChartRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+func (x *UninstallChartRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UninstallChartRequest) GetKeepHistory() bool {
+	if x != nil {
+		return x.KeepHistory
+	}
+	return false
+}
+
+func (x *UninstallChartRequest) GetDeletePvcs() bool {
+	if x != nil {
+		return x.DeletePvcs
+	}
+	return false
+}
+
+func (x *UninstallChartRequest) GetCleanupKinds() []string {
+	if x != nil {
+		return x.CleanupKinds
+	}
+	return nil
+}
+
+func (x *UninstallChartRequest) GetSkipCleanup() bool {
+	if x != nil {
+		return x.SkipCleanup
+	}
+	return false
+}
+
+// 卸载后清理的单个资源
+type ResourceDeletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Result        string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"` // deleted | not_found | failed
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceDeletion) Reset() {
+	*x = ResourceDeletion{}
+	mi := &file_helm_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDeletion) ProtoMessage() {}
+
+func (x *ResourceDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDeletion.ProtoReflect.Descriptor instead.
+func (*ResourceDeletion) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResourceDeletion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceDeletion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceDeletion) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceDeletion) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ResourceDeletion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 4. 卸载响应
+type UninstallChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`                                 // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                            // 详细信息（如错误原因）
+	OperationId   string                 `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"` // 异步操作 ID
+	Deletions     []*ResourceDeletion    `protobuf:"bytes,4,rep,name=deletions,proto3" json:"deletions,omitempty"`                        // 清理残留资源的结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UninstallChartResponse) Reset() {
+	*x = UninstallChartResponse{}
+	mi := &file_helm_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UninstallChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallChartResponse) ProtoMessage() {}
+
+func (x *UninstallChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallChartResponse.ProtoReflect.Descriptor instead.
+func (*UninstallChartResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UninstallChartResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UninstallChartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UninstallChartResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *UninstallChartResponse) GetDeletions() []*ResourceDeletion {
+	if x != nil {
+		return x.Deletions
+	}
+	return nil
+}
+
+// 5. 监控安装状态
+type WatchInstallStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseName   string                 `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // 命名空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchInstallStatusRequest) Reset() {
+	*x = WatchInstallStatusRequest{}
+	mi := &file_helm_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchInstallStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInstallStatusRequest) ProtoMessage() {}
+
+func (x *WatchInstallStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInstallStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchInstallStatusRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchInstallStatusRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *WatchInstallStatusRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type InstallStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`     // Installing | Deployed | Failed
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 状态详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallStatus) Reset() {
+	*x = InstallStatus{}
+	mi := &file_helm_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallStatus) ProtoMessage() {}
+
+func (x *InstallStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallStatus.ProtoReflect.Descriptor instead.
+func (*InstallStatus) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InstallStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *InstallStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 6. 监控 Pod 状态
+type ListPodStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // 目标命名空间
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // Helm Release 名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPodStatusRequest) Reset() {
+	*x = ListPodStatusRequest{}
+	mi := &file_helm_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodStatusRequest) ProtoMessage() {}
+
+func (x *ListPodStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodStatusRequest.ProtoReflect.Descriptor instead.
+func (*ListPodStatusRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPodStatusRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListPodStatusRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+type PodStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // Pod 名称
+	Phase         string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"` // 当前阶段（Pending/Running/Succeeded/Failed）
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Ready         string                 `protobuf:"bytes,4,opt,name=ready,proto3" json:"ready,omitempty"`        // 就绪状态（如 "1/2"）
+	Restarts      int32                  `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"` // 容器重启次数
+	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`              // Pod IP 地址
+	Node          string                 `protobuf:"bytes,7,opt,name=node,proto3" json:"node,omitempty"`          // 所在节点名称
+	Age           string                 `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Pod 标签
+	Containers    []*ContainerStatus     `protobuf:"bytes,10,rep,name=containers,proto3" json:"containers,omitempty"`                                                                  // 容器状态详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodStatus) Reset() {
+	*x = PodStatus{}
+	mi := &file_helm_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodStatus) ProtoMessage() {}
+
+func (x *PodStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodStatus.ProtoReflect.Descriptor instead.
+func (*PodStatus) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PodStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PodStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PodStatus) GetReady() string {
+	if x != nil {
+		return x.Ready
+	}
+	return ""
+}
+
+func (x *PodStatus) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *PodStatus) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *PodStatus) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PodStatus) GetAge() string {
+	if x != nil {
+		return x.Age
+	}
+	return ""
+}
+
+func (x *PodStatus) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PodStatus) GetContainers() []*ContainerStatus {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type ContainerStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ready         bool                   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	RestartCount  int32                  `protobuf:"varint,3,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // waiting/running/terminated
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerStatus) Reset() {
+	*x = ContainerStatus{}
+	mi := &file_helm_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStatus) ProtoMessage() {}
+
+func (x *ContainerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStatus.ProtoReflect.Descriptor instead.
+func (*ContainerStatus) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ContainerStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStatus) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ContainerStatus) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ContainerStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ContainerStatus) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type PodsStatusList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pods          []*PodStatus           `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"` // Pod 状态数组
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodsStatusList) Reset() {
+	*x = PodsStatusList{}
+	mi := &file_helm_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodsStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodsStatusList) ProtoMessage() {}
+
+func (x *PodsStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodsStatusList.ProtoReflect.Descriptor instead.
+func (*PodsStatusList) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PodsStatusList) GetPods() []*PodStatus {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type ListPodStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *PodsStatusList        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPodStatusResponse) Reset() {
+	*x = ListPodStatusResponse{}
+	mi := &file_helm_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodStatusResponse) ProtoMessage() {}
+
+func (x *ListPodStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListPodStatusResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPodStatusResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPodStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPodStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListPodStatusResponse) GetData() *PodsStatusList {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ========== release 资源树 ==========
+type GetReleaseResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReleaseResourcesRequest) Reset() {
+	*x = GetReleaseResourcesRequest{}
+	mi := &file_helm_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReleaseResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseResourcesRequest) ProtoMessage() {}
+
+func (x *GetReleaseResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseResourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetReleaseResourcesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetReleaseResourcesRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+type ResourceNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion    string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Health        string                 `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`                                  // Healthy | Progressing | Degraded | Missing | Unknown
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                                // 健康状态说明（如 "2/3 replicas ready"）
+	Ready         string                 `protobuf:"bytes,7,opt,name=ready,proto3" json:"ready,omitempty"`                                    // 工作负载就绪副本数（如 "2/3"）
+	Endpoints     []string               `protobuf:"bytes,8,rep,name=endpoints,proto3" json:"endpoints,omitempty"`                            // Service 就绪的 endpoint（ip:port）
+	Hosts         []string               `protobuf:"bytes,9,rep,name=hosts,proto3" json:"hosts,omitempty"`                                    // Ingress 的域名
+	LoadBalancer  []string               `protobuf:"bytes,10,rep,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"` // Service/Ingress 分配的负载均衡地址
+	Phase         string                 `protobuf:"bytes,11,opt,name=phase,proto3" json:"phase,omitempty"`                                   // PVC 的 Bound/Pending/Lost
+	Pod           *PodStatus             `protobuf:"bytes,12,opt,name=pod,proto3" json:"pod,omitempty"`                                       // kind 为 Pod 时的详细状态
+	Children      []*ResourceNode        `protobuf:"bytes,13,rep,name=children,proto3" json:"children,omitempty"`                             // 控制器管理的 Pod
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceNode) Reset() {
+	*x = ResourceNode{}
+	mi := &file_helm_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceNode) ProtoMessage() {}
+
+func (x *ResourceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceNode.ProtoReflect.Descriptor instead.
+func (*ResourceNode) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResourceNode) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetReady() string {
+	if x != nil {
+		return x.Ready
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *ResourceNode) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *ResourceNode) GetLoadBalancer() []string {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *ResourceNode) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ResourceNode) GetPod() *PodStatus {
+	if x != nil {
+		return x.Pod
+	}
+	return nil
+}
+
+func (x *ResourceNode) GetChildren() []*ResourceNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ReleaseResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseName   string                 `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // helm release 状态
+	Health        string                 `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"` // 所有资源中最差的健康状态
+	Resources     []*ResourceNode        `protobuf:"bytes,6,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseResources) Reset() {
+	*x = ReleaseResources{}
+	mi := &file_helm_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseResources) ProtoMessage() {}
+
+func (x *ReleaseResources) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseResources.ProtoReflect.Descriptor instead.
+func (*ReleaseResources) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReleaseResources) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ReleaseResources) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReleaseResources) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ReleaseResources) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReleaseResources) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *ReleaseResources) GetResources() []*ResourceNode {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type GetReleaseResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *ReleaseResources      `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReleaseResourcesResponse) Reset() {
+	*x = GetReleaseResourcesResponse{}
+	mi := &file_helm_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReleaseResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseResourcesResponse) ProtoMessage() {}
+
+func (x *GetReleaseResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseResourcesResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetReleaseResourcesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetReleaseResourcesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReleaseResourcesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReleaseResourcesResponse) GetData() *ReleaseResources {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 7. 检查 ApisixRoute
+type CheckApisixRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseName   string                 `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // 命名空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckApisixRouteRequest) Reset() {
+	*x = CheckApisixRouteRequest{}
+	mi := &file_helm_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckApisixRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckApisixRouteRequest) ProtoMessage() {}
+
+func (x *CheckApisixRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckApisixRouteRequest.ProtoReflect.Descriptor instead.
+func (*CheckApisixRouteRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckApisixRouteRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *CheckApisixRouteRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CheckApisixRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	RouteUrl      string                 `protobuf:"bytes,2,opt,name=route_url,json=routeUrl,proto3" json:"route_url,omitempty"` // 访问方式（如 http://apisix-gateway/route）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckApisixRouteResponse) Reset() {
+	*x = CheckApisixRouteResponse{}
+	mi := &file_helm_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckApisixRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckApisixRouteResponse) ProtoMessage() {}
+
+func (x *CheckApisixRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckApisixRouteResponse.ProtoReflect.Descriptor instead.
+func (*CheckApisixRouteResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckApisixRouteResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *CheckApisixRouteResponse) GetRouteUrl() string {
+	if x != nil {
+		return x.RouteUrl
+	}
+	return ""
+}
+
+// 8. 创建 Chart 应用
+type CreateChartApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChartRef      string                 `protobuf:"bytes,3,opt,name=chart_ref,json=chartRef,proto3" json:"chart_ref,omitempty"` // Chart 引用（如 repo/chart）
+	Values        string                 `protobuf:"bytes,4,opt,name=values,proto3" json:"values,omitempty"`                     // values.yaml 内容
+	Scope         *WorkspaceScope        `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`                       // 按工作空间创建（可选），目标为对应的托管命名空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChartApplicationRequest) Reset() {
+	*x = CreateChartApplicationRequest{}
+	mi := &file_helm_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChartApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChartApplicationRequest) ProtoMessage() {}
+
+func (x *CreateChartApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChartApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateChartApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateChartApplicationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateChartApplicationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChartApplicationRequest) GetChartRef() string {
+	if x != nil {
+		return x.ChartRef
+	}
+	return ""
+}
+
+func (x *CreateChartApplicationRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *CreateChartApplicationRequest) GetScope() *WorkspaceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type CreateChartApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChartApplicationResponse) Reset() {
+	*x = CreateChartApplicationResponse{}
+	mi := &file_helm_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChartApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChartApplicationResponse) ProtoMessage() {}
+
+func (x *CreateChartApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChartApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateChartApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateChartApplicationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 10. 检查 Pod 终端
+type CheckPodTerminalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName       string                 `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"` // 容器名称（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPodTerminalRequest) Reset() {
+	*x = CheckPodTerminalRequest{}
+	mi := &file_helm_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPodTerminalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPodTerminalRequest) ProtoMessage() {}
+
+func (x *CheckPodTerminalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPodTerminalRequest.ProtoReflect.Descriptor instead.
+func (*CheckPodTerminalRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CheckPodTerminalRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CheckPodTerminalRequest) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *CheckPodTerminalRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type CheckPodTerminalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supported     bool                   `protobuf:"varint,1,opt,name=supported,proto3" json:"supported,omitempty"`                          // 是否支持终端
+	WebsocketUrl  string                 `protobuf:"bytes,2,opt,name=websocket_url,json=websocketUrl,proto3" json:"websocket_url,omitempty"` // WebSocket 连接地址（如 ws://service/terminal）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPodTerminalResponse) Reset() {
+	*x = CheckPodTerminalResponse{}
+	mi := &file_helm_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPodTerminalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPodTerminalResponse) ProtoMessage() {}
+
+func (x *CheckPodTerminalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPodTerminalResponse.ProtoReflect.Descriptor instead.
+func (*CheckPodTerminalResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CheckPodTerminalResponse) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
+func (x *CheckPodTerminalResponse) GetWebsocketUrl() string {
+	if x != nil {
+		return x.WebsocketUrl
+	}
+	return ""
+}
+
+// ========== 通用消息定义 ==========
+type ChartSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChartName     string                 `protobuf:"bytes,1,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`                                                    // Chart 名称（如 "nginx"），也可以是 oci:// 引用
+	ChartVersion  string                 `protobuf:"bytes,2,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`                                           // Chart 版本（如 "1.2.3"）
+	RepoUrl       string                 `protobuf:"bytes,3,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`                                                          // 仓库地址（可选，支持 oci://）
+	Values        map[string]string      `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 自定义 Values，key 为 --set 风格的路径（如 "image.tag"），值会自动推断类型
+	RepoName      string                 `protobuf:"bytes,5,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`                                                       // 仓库名称（可选，默认 harbor）
+	ValuesYaml    string                 `protobuf:"bytes,6,opt,name=values_yaml,json=valuesYaml,proto3" json:"values_yaml,omitempty"`                                                 // values 文档（YAML/JSON），与 InstallChartRequest.values 相同
+	Set           []string               `protobuf:"bytes,7,rep,name=set,proto3" json:"set,omitempty"`                                                                                 // --set 参数（如 "image.tag=1.2,replicaCount=3"）
+	SetString     []string               `protobuf:"bytes,8,rep,name=set_string,json=setString,proto3" json:"set_string,omitempty"`                                                    // --set-string 参数，值始终作为字符串
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartSpec) Reset() {
+	*x = ChartSpec{}
+	mi := &file_helm_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartSpec) ProtoMessage() {}
+
+func (x *ChartSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartSpec.ProtoReflect.Descriptor instead.
+func (*ChartSpec) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChartSpec) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *ChartSpec) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *ChartSpec) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ChartSpec) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ChartSpec) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ChartSpec) GetValuesYaml() string {
+	if x != nil {
+		return x.ValuesYaml
+	}
+	return ""
+}
+
+func (x *ChartSpec) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *ChartSpec) GetSetString() []string {
+	if x != nil {
+		return x.SetString
+	}
+	return nil
+}
+
+// ========== 升级请求/响应 ==========
+type UpgradeChartRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Namespace            string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName          string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Chart                *ChartSpec             `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	Force                bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                                               // 是否强制升级（--force）
+	RecreatePods         bool                   `protobuf:"varint,5,opt,name=recreate_pods,json=recreatePods,proto3" json:"recreate_pods,omitempty"`                             // 是否重启 Pod（--recreate-pods）
+	ReuseValues          bool                   `protobuf:"varint,6,opt,name=reuse_values,json=reuseValues,proto3" json:"reuse_values,omitempty"`                                // 沿用上次的 values 并合并本次的 values（--reuse-values）
+	ResetValues          bool                   `protobuf:"varint,7,opt,name=reset_values,json=resetValues,proto3" json:"reset_values,omitempty"`                                // 使用 chart 默认 values，丢弃上次的 values（--reset-values）
+	ResetThenReuseValues bool                   `protobuf:"varint,8,opt,name=reset_then_reuse_values,json=resetThenReuseValues,proto3" json:"reset_then_reuse_values,omitempty"` // 使用新 chart 的默认 values，再合并上次的 values（--reset-then-reuse-values）
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpgradeChartRequest) Reset() {
+	*x = UpgradeChartRequest{}
+	mi := &file_helm_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeChartRequest) ProtoMessage() {}
+
+func (x *UpgradeChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeChartRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeChartRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpgradeChartRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpgradeChartRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *UpgradeChartRequest) GetChart() *ChartSpec {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+func (x *UpgradeChartRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *UpgradeChartRequest) GetRecreatePods() bool {
+	if x != nil {
+		return x.RecreatePods
+	}
+	return false
+}
+
+func (x *UpgradeChartRequest) GetReuseValues() bool {
+	if x != nil {
+		return x.ReuseValues
+	}
+	return false
+}
+
+func (x *UpgradeChartRequest) GetResetValues() bool {
+	if x != nil {
+		return x.ResetValues
+	}
+	return false
+}
+
+func (x *UpgradeChartRequest) GetResetThenReuseValues() bool {
+	if x != nil {
+		return x.ResetThenReuseValues
+	}
+	return false
+}
+
+type UpgradeChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Revision      string                 `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`                          // 新版本号（如 "2"）
+	OperationId   string                 `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"` // 异步操作 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeChartResponse) Reset() {
+	*x = UpgradeChartResponse{}
+	mi := &file_helm_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeChartResponse) ProtoMessage() {}
+
+func (x *UpgradeChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeChartResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeChartResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpgradeChartResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpgradeChartResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *UpgradeChartResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// ========== 升级预览请求/响应 ==========
+type DiffReleaseRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Namespace            string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName          string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Chart                *ChartSpec             `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`                                    // 升级目标，与 UpgradeChart 相同
+	CompareLive          bool                   `protobuf:"varint,4,opt,name=compare_live,json=compareLive,proto3" json:"compare_live,omitempty"`    // 是否同时与集群中的实际资源对比
+	ContextLines         int32                  `protobuf:"varint,5,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"` // diff 上下文行数（默认 3）
+	ReuseValues          bool                   `protobuf:"varint,6,opt,name=reuse_values,json=reuseValues,proto3" json:"reuse_values,omitempty"`    // 与 UpgradeChartRequest 相同
+	ResetValues          bool                   `protobuf:"varint,7,opt,name=reset_values,json=resetValues,proto3" json:"reset_values,omitempty"`
+	ResetThenReuseValues bool                   `protobuf:"varint,8,opt,name=reset_then_reuse_values,json=resetThenReuseValues,proto3" json:"reset_then_reuse_values,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiffReleaseRequest) Reset() {
+	*x = DiffReleaseRequest{}
+	mi := &file_helm_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffReleaseRequest) ProtoMessage() {}
+
+func (x *DiffReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffReleaseRequest.ProtoReflect.Descriptor instead.
+func (*DiffReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DiffReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DiffReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *DiffReleaseRequest) GetChart() *ChartSpec {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+func (x *DiffReleaseRequest) GetCompareLive() bool {
+	if x != nil {
+		return x.CompareLive
+	}
+	return false
+}
+
+func (x *DiffReleaseRequest) GetContextLines() int32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+func (x *DiffReleaseRequest) GetReuseValues() bool {
+	if x != nil {
+		return x.ReuseValues
+	}
+	return false
+}
+
+func (x *DiffReleaseRequest) GetResetValues() bool {
+	if x != nil {
+		return x.ResetValues
+	}
+	return false
+}
+
+func (x *DiffReleaseRequest) GetResetThenReuseValues() bool {
+	if x != nil {
+		return x.ResetThenReuseValues
+	}
+	return false
+}
+
+type ResourceDiff struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace  string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Change     string                 `protobuf:"bytes,5,opt,name=change,proto3" json:"change,omitempty"` // added | removed | changed | unchanged
+	// 与当前 release manifest 的 unified diff。diff 和 live_diff 中 Secret 的 data/stringData 值
+	// 替换为 "(redacted <摘要>)"，摘要只在同一次请求内可比较，用于判断哪些键发生了变化
+	Diff             string   `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	LiveDiff         string   `protobuf:"bytes,7,opt,name=live_diff,json=liveDiff,proto3" json:"live_diff,omitempty"`                      // 与集群实际资源的 unified diff（compare_live 时返回）
+	ImmutableFields  []string `protobuf:"bytes,8,rep,name=immutable_fields,json=immutableFields,proto3" json:"immutable_fields,omitempty"` // 发生变化的不可变字段，升级时需要删除重建
+	RequiresRecreate bool     `protobuf:"varint,9,opt,name=requires_recreate,json=requiresRecreate,proto3" json:"requires_recreate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResourceDiff) Reset() {
+	*x = ResourceDiff{}
+	mi := &file_helm_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDiff) ProtoMessage() {}
+
+func (x *ResourceDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDiff.ProtoReflect.Descriptor instead.
+func (*ResourceDiff) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResourceDiff) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetLiveDiff() string {
+	if x != nil {
+		return x.LiveDiff
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetImmutableFields() []string {
+	if x != nil {
+		return x.ImmutableFields
+	}
+	return nil
+}
+
+func (x *ResourceDiff) GetRequiresRecreate() bool {
+	if x != nil {
+		return x.RequiresRecreate
+	}
+	return false
+}
+
+type DiffReleaseResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	CurrentRevision int32                  `protobuf:"varint,4,opt,name=current_revision,json=currentRevision,proto3" json:"current_revision,omitempty"`
+	CurrentChart    string                 `protobuf:"bytes,5,opt,name=current_chart,json=currentChart,proto3" json:"current_chart,omitempty"` // 当前 chart（name-version）
+	TargetChart     string                 `protobuf:"bytes,6,opt,name=target_chart,json=targetChart,proto3" json:"target_chart,omitempty"`    // 升级后的 chart（name-version）
+	Resources       []*ResourceDiff        `protobuf:"bytes,7,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiffReleaseResponse) Reset() {
+	*x = DiffReleaseResponse{}
+	mi := &file_helm_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffReleaseResponse) ProtoMessage() {}
+
+func (x *DiffReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffReleaseResponse.ProtoReflect.Descriptor instead.
+func (*DiffReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiffReleaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DiffReleaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DiffReleaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DiffReleaseResponse) GetCurrentRevision() int32 {
+	if x != nil {
+		return x.CurrentRevision
+	}
+	return 0
+}
+
+func (x *DiffReleaseResponse) GetCurrentChart() string {
+	if x != nil {
+		return x.CurrentChart
+	}
+	return ""
+}
+
+func (x *DiffReleaseResponse) GetTargetChart() string {
+	if x != nil {
+		return x.TargetChart
+	}
+	return ""
+}
+
+func (x *DiffReleaseResponse) GetResources() []*ResourceDiff {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// ========== 回滚请求/响应 ==========
+type RollbackChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Revision      string                 `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`                                   // 目标回滚版本号（如 "1"）
+	Wait          bool                   `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`                                          // 是否等待回滚完成（--wait）
+	Timeout       string                 `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                                     // 等待超时时间（如 "5m"，默认 5m）
+	CleanupOnFail bool                   `protobuf:"varint,6,opt,name=cleanup_on_fail,json=cleanupOnFail,proto3" json:"cleanup_on_fail,omitempty"` // 回滚失败时删除本次新建的资源（--cleanup-on-fail）
+	Force         bool                   `protobuf:"varint,7,opt,name=force,proto3" json:"force,omitempty"`                                        // 必要时删除重建资源（--force）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackChartRequest) Reset() {
+	*x = RollbackChartRequest{}
+	mi := &file_helm_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackChartRequest) ProtoMessage() {}
+
+func (x *RollbackChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackChartRequest.ProtoReflect.Descriptor instead.
+func (*RollbackChartRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RollbackChartRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RollbackChartRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *RollbackChartRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *RollbackChartRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *RollbackChartRequest) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *RollbackChartRequest) GetCleanupOnFail() bool {
+	if x != nil {
+		return x.CleanupOnFail
+	}
+	return false
+}
+
+func (x *RollbackChartRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RollbackChartResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentRevision string                 `protobuf:"bytes,2,opt,name=current_revision,json=currentRevision,proto3" json:"current_revision,omitempty"` // 回滚后的版本号
+	OperationId     string                 `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`             // 异步操作 ID
+	ChartVersion    string                 `protobuf:"bytes,4,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`          // 回滚后的 chart 版本
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                // 回滚后 release 的描述
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RollbackChartResponse) Reset() {
+	*x = RollbackChartResponse{}
+	mi := &file_helm_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackChartResponse) ProtoMessage() {}
+
+func (x *RollbackChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackChartResponse.ProtoReflect.Descriptor instead.
+func (*RollbackChartResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RollbackChartResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RollbackChartResponse) GetCurrentRevision() string {
+	if x != nil {
+		return x.CurrentRevision
+	}
+	return ""
+}
+
+func (x *RollbackChartResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *RollbackChartResponse) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *RollbackChartResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ========== 历史版本请求/响应 ==========
+type GetReleaseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Max           int32                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"` // 最多返回的版本数（默认 256）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReleaseHistoryRequest) Reset() {
+	*x = GetReleaseHistoryRequest{}
+	mi := &file_helm_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReleaseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseHistoryRequest) ProtoMessage() {}
+
+func (x *GetReleaseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetReleaseHistoryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetReleaseHistoryRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *GetReleaseHistoryRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ReleaseRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Chart         string                 `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"` // chart 名称
+	ChartVersion  string                 `protobuf:"bytes,4,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	FirstDeployed string                 `protobuf:"bytes,7,opt,name=first_deployed,json=firstDeployed,proto3" json:"first_deployed,omitempty"` // RFC3339
+	LastDeployed  string                 `protobuf:"bytes,8,opt,name=last_deployed,json=lastDeployed,proto3" json:"last_deployed,omitempty"`    // RFC3339
+	Deleted       string                 `protobuf:"bytes,9,opt,name=deleted,proto3" json:"deleted,omitempty"`                                  // RFC3339，未删除时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRevision) Reset() {
+	*x = ReleaseRevision{}
+	mi := &file_helm_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRevision) ProtoMessage() {}
+
+func (x *ReleaseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRevision.ProtoReflect.Descriptor instead.
+func (*ReleaseRevision) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReleaseRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ReleaseRevision) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetFirstDeployed() string {
+	if x != nil {
+		return x.FirstDeployed
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetLastDeployed() string {
+	if x != nil {
+		return x.LastDeployed
+	}
+	return ""
+}
+
+func (x *ReleaseRevision) GetDeleted() string {
+	if x != nil {
+		return x.Deleted
+	}
+	return ""
+}
+
+type GetReleaseHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Revisions     []*ReleaseRevision     `protobuf:"bytes,4,rep,name=revisions,proto3" json:"revisions,omitempty"` // 按版本号从新到旧
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReleaseHistoryResponse) Reset() {
+	*x = GetReleaseHistoryResponse{}
+	mi := &file_helm_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReleaseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseHistoryResponse) ProtoMessage() {}
+
+func (x *GetReleaseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetReleaseHistoryResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetReleaseHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReleaseHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReleaseHistoryResponse) GetRevisions() []*ReleaseRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// ========== 请求/响应定义 ==========
+type ListChartVersionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RepoName          string                 `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`                             // 仓库名称（如 "stable"）
+	ChartName         string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`                          // Chart 名称（如 "nginx"）
+	WithDetails       bool                   `protobuf:"varint,3,opt,name=with_details,json=withDetails,proto3" json:"with_details,omitempty"`                   // 是否返回详细元数据（如更新时间）
+	Constraint        string                 `protobuf:"bytes,4,opt,name=constraint,proto3" json:"constraint,omitempty"`                                         // semver 约束（可选，如 ">=1.2 <2"）
+	IncludePrerelease bool                   `protobuf:"varint,5,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"` // 是否包含预发布版本（如 1.0.0-rc1）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListChartVersionsRequest) Reset() {
+	*x = ListChartVersionsRequest{}
+	mi := &file_helm_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChartVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChartVersionsRequest) ProtoMessage() {}
+
+func (x *ListChartVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChartVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListChartVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListChartVersionsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ListChartVersionsRequest) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *ListChartVersionsRequest) GetWithDetails() bool {
+	if x != nil {
+		return x.WithDetails
+	}
+	return false
+}
+
+func (x *ListChartVersionsRequest) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *ListChartVersionsRequest) GetIncludePrerelease() bool {
+	if x != nil {
+		return x.IncludePrerelease
+	}
+	return false
+}
+
+type ChartVersionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                         // 版本号（如 "1.2.3"）
+	AppVersion    string                 `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"` // 应用版本（如 "1.20.0"）
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // 版本描述
+	Created       string                 `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`                         // 创建时间（RFC3339 格式）
+	Digest        string                 `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`                           // chart 包摘要
+	Deprecated    bool                   `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`                  // 是否已废弃
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartVersionInfo) Reset() {
+	*x = ChartVersionInfo{}
+	mi := &file_helm_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartVersionInfo) ProtoMessage() {}
+
+func (x *ChartVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartVersionInfo.ProtoReflect.Descriptor instead.
+func (*ChartVersionInfo) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ChartVersionInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChartVersionInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ChartVersionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChartVersionInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *ChartVersionInfo) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ChartVersionInfo) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+type ListChartVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ChartVersionInfo    `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	RepoUrl       string                 `protobuf:"bytes,2,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"` // 仓库地址（如 "https://charts.helm.sh/stable"）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChartVersionsResponse) Reset() {
+	*x = ListChartVersionsResponse{}
+	mi := &file_helm_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChartVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChartVersionsResponse) ProtoMessage() {}
+
+func (x *ListChartVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChartVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListChartVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListChartVersionsResponse) GetVersions() []*ChartVersionInfo {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListChartVersionsResponse) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+type ListInstalledChartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                            // 目标命名空间（空或 all 表示当前身份可见的所有命名空间）
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`     // release 名称正则表达式（可选）
+	WithStatus    bool                   `protobuf:"varint,3,opt,name=with_status,json=withStatus,proto3" json:"with_status,omitempty"`       // 是否包含状态描述信息
+	WithManifest  bool                   `protobuf:"varint,4,opt,name=with_manifest,json=withManifest,proto3" json:"with_manifest,omitempty"` // 是否包含资源清单
+	Status        []string               `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`                                  // 按状态过滤：deployed | failed | pending-install | pending-upgrade | pending-rollback | uninstalling | uninstalled | superseded，空表示 uninstalled 和 superseded 以外的所有状态
+	ChartName     string                 `protobuf:"bytes,6,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`           // 按 chart 名称过滤（可选）
+	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                    // 排序字段：name（默认）| namespace | chart | updated
+	Desc          bool                   `protobuf:"varint,8,opt,name=desc,proto3" json:"desc,omitempty"`                                     // 是否倒序
+	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`                                 // 跳过的条数
+	Limit         int32                  `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`                                  // 返回的最大条数，0 表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstalledChartsRequest) Reset() {
+	*x = ListInstalledChartsRequest{}
+	mi := &file_helm_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstalledChartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstalledChartsRequest) ProtoMessage() {}
+
+func (x *ListInstalledChartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstalledChartsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstalledChartsRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListInstalledChartsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListInstalledChartsRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ListInstalledChartsRequest) GetWithStatus() bool {
+	if x != nil {
+		return x.WithStatus
+	}
+	return false
+}
+
+func (x *ListInstalledChartsRequest) GetWithManifest() bool {
+	if x != nil {
+		return x.WithManifest
+	}
+	return false
+}
+
+func (x *ListInstalledChartsRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListInstalledChartsRequest) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *ListInstalledChartsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListInstalledChartsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListInstalledChartsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListInstalledChartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListInstalledChartsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 过滤后、分页前的总数
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Releases      []*InstalledChart      `protobuf:"bytes,4,rep,name=releases,proto3" json:"releases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstalledChartsData) Reset() {
+	*x = ListInstalledChartsData{}
+	mi := &file_helm_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstalledChartsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstalledChartsData) ProtoMessage() {}
+
+func (x *ListInstalledChartsData) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstalledChartsData.ProtoReflect.Descriptor instead.
+func (*ListInstalledChartsData) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListInstalledChartsData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInstalledChartsData) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListInstalledChartsData) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInstalledChartsData) GetReleases() []*InstalledChart {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+type ListInstalledChartsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Code          int32                    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                     `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *ListInstalledChartsData `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstalledChartsResponse) Reset() {
+	*x = ListInstalledChartsResponse{}
+	mi := &file_helm_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstalledChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstalledChartsResponse) ProtoMessage() {}
+
+func (x *ListInstalledChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstalledChartsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstalledChartsResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListInstalledChartsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListInstalledChartsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListInstalledChartsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListInstalledChartsResponse) GetData() *ListInstalledChartsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InstalledChart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Release 名称
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                           // 所属命名空间
+	ChartName     string                 `protobuf:"bytes,3,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`          // Chart 名称（如 nginx）
+	ChartVersion  string                 `protobuf:"bytes,4,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"` // Chart 版本（如 1.2.3）
+	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`       // 应用版本
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                 // 状态（deployed/failed/pending-install 等）
+	Manifest      string                 `protobuf:"bytes,7,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Updated       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`                                                                         // 最后更新时间
+	Values        map[string]string      `protobuf:"bytes,9,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户自定义 values
+	Revision      int32                  `protobuf:"varint,10,opt,name=revision,proto3" json:"revision,omitempty"`                                                                     // 当前版本号
+	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`                                                                // 状态描述，with_status 时返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstalledChart) Reset() {
+	*x = InstalledChart{}
+	mi := &file_helm_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstalledChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledChart) ProtoMessage() {}
+
+func (x *InstalledChart) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledChart.ProtoReflect.Descriptor instead.
+func (*InstalledChart) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InstalledChart) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *InstalledChart) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *InstalledChart) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *InstalledChart) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *InstalledChart) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ========== 异步操作 ==========
+type Operation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // install | upgrade | uninstall | rollback
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ReleaseName   string                 `protobuf:"bytes,4,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`   // Pending | Running | Succeeded | Failed | Cancelled
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"` // 进度或错误信息
+	Result        *anypb.Any             `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`   // 操作完成后的响应（如 InstallChartResponse）
+	UserId        string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_helm_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Operation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Operation) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Operation) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Operation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Operation) GetResult() *anypb.Any {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Operation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type GetOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	mi := &file_helm_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type GetOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Data          *PodsStatusList        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *Operation             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationResponse) Reset() {
+	*x = GetOperationResponse{}
+	mi := &file_helm_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationResponse) ProtoMessage() {}
+
+func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetOperationResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetOperationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOperationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOperationResponse) GetData() *Operation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // 按命名空间过滤（可选）
+	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"` // 按 release 过滤（可选）
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                  // 按操作类型过滤（可选）
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                              // 按状态过滤（可选）
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                               // 页码，从 1 开始
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                                 // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	mi := &file_helm_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListOperationsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListOperationsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Operations    []*Operation           `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPodStatusResponse) Reset() {
-	*x = ListPodStatusResponse{}
-	mi := &file_helm_service_proto_msgTypes[18]
+func (x *ListOperationsData) Reset() {
+	*x = ListOperationsData{}
+	mi := &file_helm_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPodStatusResponse) String() string {
+func (x *ListOperationsData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPodStatusResponse) ProtoMessage() {}
+func (*ListOperationsData) ProtoMessage() {}
 
-func (x *ListPodStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[18]
+func (x *ListOperationsData) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,63 +5098,50 @@ func (x *ListPodStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPodStatusResponse.ProtoReflect.Descriptor instead.
-func (*ListPodStatusResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListOperationsData.ProtoReflect.Descriptor instead.
+func (*ListOperationsData) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListPodStatusResponse) GetCode() int32 {
+func (x *ListOperationsData) GetTotal() int32 {
 	if x != nil {
-		return x.Code
+		return x.Total
 	}
 	return 0
 }
 
-func (x *ListPodStatusResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ListPodStatusResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ListPodStatusResponse) GetData() *PodsStatusList {
+func (x *ListOperationsData) GetOperations() []*Operation {
 	if x != nil {
-		return x.Data
+		return x.Operations
 	}
 	return nil
 }
 
-// 7. 检查 ApisixRoute
-type CheckApisixRouteRequest struct {
+type ListOperationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReleaseName   string                 `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // 命名空间
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *ListOperationsData    `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckApisixRouteRequest) Reset() {
-	*x = CheckApisixRouteRequest{}
-	mi := &file_helm_service_proto_msgTypes[19]
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	mi := &file_helm_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckApisixRouteRequest) String() string {
+func (x *ListOperationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckApisixRouteRequest) ProtoMessage() {}
+func (*ListOperationsResponse) ProtoMessage() {}
 
-func (x *CheckApisixRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[19]
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,48 +5152,61 @@ func (x *CheckApisixRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckApisixRouteRequest.ProtoReflect.Descriptor instead.
-func (*CheckApisixRouteRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CheckApisixRouteRequest) GetReleaseName() string {
+func (x *ListOperationsResponse) GetCode() int32 {
 	if x != nil {
-		return x.ReleaseName
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckApisixRouteRequest) GetNamespace() string {
+func (x *ListOperationsResponse) GetMessage() string {
 	if x != nil {
-		return x.Namespace
+		return x.Message
 	}
 	return ""
 }
 
-type CheckApisixRouteResponse struct {
+func (x *ListOperationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListOperationsResponse) GetData() *ListOperationsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CancelOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	RouteUrl      string                 `protobuf:"bytes,2,opt,name=route_url,json=routeUrl,proto3" json:"route_url,omitempty"` // 访问方式（如 http://apisix-gateway/route）
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckApisixRouteResponse) Reset() {
-	*x = CheckApisixRouteResponse{}
-	mi := &file_helm_service_proto_msgTypes[20]
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	mi := &file_helm_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckApisixRouteResponse) String() string {
+func (x *CancelOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckApisixRouteResponse) ProtoMessage() {}
+func (*CancelOperationRequest) ProtoMessage() {}
 
-func (x *CheckApisixRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[20]
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,51 +5217,43 @@ func (x *CheckApisixRouteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckApisixRouteResponse.ProtoReflect.Descriptor instead.
-func (*CheckApisixRouteResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CheckApisixRouteResponse) GetExists() bool {
-	if x != nil {
-		return x.Exists
-	}
-	return false
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CheckApisixRouteResponse) GetRouteUrl() string {
+func (x *CancelOperationRequest) GetOperationId() string {
 	if x != nil {
-		return x.RouteUrl
+		return x.OperationId
 	}
 	return ""
 }
 
-// 8. 创建 Chart 应用
-type CreateChartApplicationRequest struct {
+type CancelOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ChartRef      string                 `protobuf:"bytes,3,opt,name=chart_ref,json=chartRef,proto3" json:"chart_ref,omitempty"` // Chart 引用（如 repo/chart）
-	Values        string                 `protobuf:"bytes,4,opt,name=values,proto3" json:"values,omitempty"`                     // values.yaml 内容
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Data          *Operation             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChartApplicationRequest) Reset() {
-	*x = CreateChartApplicationRequest{}
-	mi := &file_helm_service_proto_msgTypes[21]
+func (x *CancelOperationResponse) Reset() {
+	*x = CancelOperationResponse{}
+	mi := &file_helm_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChartApplicationRequest) String() string {
+func (x *CancelOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChartApplicationRequest) ProtoMessage() {}
+func (*CancelOperationResponse) ProtoMessage() {}
 
-func (x *CreateChartApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[21]
+func (x *CancelOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,61 +5264,66 @@ func (x *CreateChartApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChartApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateChartApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CancelOperationResponse.ProtoReflect.Descriptor instead.
+func (*CancelOperationResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateChartApplicationRequest) GetNamespace() string {
+func (x *CancelOperationResponse) GetCode() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateChartApplicationRequest) GetName() string {
+func (x *CancelOperationResponse) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateChartApplicationRequest) GetChartRef() string {
+func (x *CancelOperationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ChartRef
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateChartApplicationRequest) GetValues() string {
+func (x *CancelOperationResponse) GetData() *Operation {
 	if x != nil {
-		return x.Values
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type CreateChartApplicationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+// 26. 流式上传 chart 包
+type UploadChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadChartRequest_Metadata
+	//	*UploadChartRequest_Chunk
+	Payload       isUploadChartRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChartApplicationResponse) Reset() {
-	*x = CreateChartApplicationResponse{}
-	mi := &file_helm_service_proto_msgTypes[22]
+func (x *UploadChartRequest) Reset() {
+	*x = UploadChartRequest{}
+	mi := &file_helm_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChartApplicationResponse) String() string {
+func (x *UploadChartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChartApplicationResponse) ProtoMessage() {}
+func (*UploadChartRequest) ProtoMessage() {}
 
-func (x *CreateChartApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[22]
+func (x *UploadChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,43 +5334,80 @@ func (x *CreateChartApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChartApplicationResponse.ProtoReflect.Descriptor instead.
-func (*CreateChartApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UploadChartRequest.ProtoReflect.Descriptor instead.
+func (*UploadChartRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CreateChartApplicationResponse) GetSuccess() bool {
+func (x *UploadChartRequest) GetPayload() isUploadChartRequest_Payload {
 	if x != nil {
-		return x.Success
+		return x.Payload
 	}
-	return false
+	return nil
 }
 
-// 10. 检查 Pod 终端
-type CheckPodTerminalRequest struct {
+func (x *UploadChartRequest) GetMetadata() *UploadChartMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadChartRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadChartRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadChartRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadChartRequest_Payload interface {
+	isUploadChartRequest_Payload()
+}
+
+type UploadChartRequest_Metadata struct {
+	Metadata *UploadChartMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"` // 第一条消息
+}
+
+type UploadChartRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // 之后的文件分片，按顺序追加
+}
+
+func (*UploadChartRequest_Metadata) isUploadChartRequest_Payload() {}
+
+func (*UploadChartRequest_Chunk) isUploadChartRequest_Payload() {}
+
+type UploadChartMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PodName       string                 `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
-	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"` // 容器名称（可选）
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // 续传时填写首次上传返回的 upload_id（响应头 upload-id），为空表示新上传
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // 原始文件名，仅用于记录
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                        // 文件总大小（字节）
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`                     // 文件的 sha256（hex），接收完成后校验
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                    // 本次分片的起始位置，必须等于服务端已接收的字节数
+	RepoName      string                 `protobuf:"bytes,6,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"` // 推送的目标项目，默认 library
+	Provenance    []byte                 `protobuf:"bytes,7,opt,name=provenance,proto3" json:"provenance,omitempty"`             // 可选的 .prov 签名文件内容
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPodTerminalRequest) Reset() {
-	*x = CheckPodTerminalRequest{}
-	mi := &file_helm_service_proto_msgTypes[23]
+func (x *UploadChartMetadata) Reset() {
+	*x = UploadChartMetadata{}
+	mi := &file_helm_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPodTerminalRequest) String() string {
+func (x *UploadChartMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPodTerminalRequest) ProtoMessage() {}
+func (*UploadChartMetadata) ProtoMessage() {}
 
-func (x *CheckPodTerminalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[23]
+func (x *UploadChartMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,110 +5418,84 @@ func (x *CheckPodTerminalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPodTerminalRequest.ProtoReflect.Descriptor instead.
-func (*CheckPodTerminalRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UploadChartMetadata.ProtoReflect.Descriptor instead.
+func (*UploadChartMetadata) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CheckPodTerminalRequest) GetNamespace() string {
+func (x *UploadChartMetadata) GetUploadId() string {
 	if x != nil {
-		return x.Namespace
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *CheckPodTerminalRequest) GetPodName() string {
+func (x *UploadChartMetadata) GetFileName() string {
 	if x != nil {
-		return x.PodName
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *CheckPodTerminalRequest) GetContainer() string {
+func (x *UploadChartMetadata) GetSize() int64 {
 	if x != nil {
-		return x.Container
+		return x.Size
 	}
-	return ""
-}
-
-type CheckPodTerminalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Supported     bool                   `protobuf:"varint,1,opt,name=supported,proto3" json:"supported,omitempty"`                          // 是否支持终端
-	WebsocketUrl  string                 `protobuf:"bytes,2,opt,name=websocket_url,json=websocketUrl,proto3" json:"websocket_url,omitempty"` // WebSocket 连接地址（如 ws://service/terminal）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CheckPodTerminalResponse) Reset() {
-	*x = CheckPodTerminalResponse{}
-	mi := &file_helm_service_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CheckPodTerminalResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*CheckPodTerminalResponse) ProtoMessage() {}
-
-func (x *CheckPodTerminalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[24]
+func (x *UploadChartMetadata) GetSha256() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sha256
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CheckPodTerminalResponse.ProtoReflect.Descriptor instead.
-func (*CheckPodTerminalResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{24}
+func (x *UploadChartMetadata) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *CheckPodTerminalResponse) GetSupported() bool {
+func (x *UploadChartMetadata) GetRepoName() string {
 	if x != nil {
-		return x.Supported
+		return x.RepoName
 	}
-	return false
+	return ""
 }
 
-func (x *CheckPodTerminalResponse) GetWebsocketUrl() string {
+func (x *UploadChartMetadata) GetProvenance() []byte {
 	if x != nil {
-		return x.WebsocketUrl
+		return x.Provenance
 	}
-	return ""
+	return nil
 }
 
-// ========== 通用消息定义 ==========
-type ChartSpec struct {
+type ChartLintMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChartName     string                 `protobuf:"bytes,1,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`                                                    // Chart 名称（如 "nginx"）
-	ChartVersion  string                 `protobuf:"bytes,2,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`                                           // Chart 版本（如 "1.2.3"）
-	RepoUrl       string                 `protobuf:"bytes,3,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`                                                          // 仓库地址（可选）
-	Values        map[string]string      `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 自定义 Values
+	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"` // INFO | WARNING | ERROR
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChartSpec) Reset() {
-	*x = ChartSpec{}
-	mi := &file_helm_service_proto_msgTypes[25]
+func (x *ChartLintMessage) Reset() {
+	*x = ChartLintMessage{}
+	mi := &file_helm_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChartSpec) String() string {
+func (x *ChartLintMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChartSpec) ProtoMessage() {}
+func (*ChartLintMessage) ProtoMessage() {}
 
-func (x *ChartSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[25]
+func (x *ChartLintMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,69 +5503,69 @@ func (x *ChartSpec) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChartSpec.ProtoReflect.Descriptor instead.
-func (*ChartSpec) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{25}
+	return mi.MessageOf(x)
 }
 
-func (x *ChartSpec) GetChartName() string {
-	if x != nil {
-		return x.ChartName
-	}
-	return ""
+// Deprecated: Use ChartLintMessage.ProtoReflect.Descriptor instead.
+func (*ChartLintMessage) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ChartSpec) GetChartVersion() string {
+func (x *ChartLintMessage) GetSeverity() string {
 	if x != nil {
-		return x.ChartVersion
+		return x.Severity
 	}
 	return ""
 }
 
-func (x *ChartSpec) GetRepoUrl() string {
+func (x *ChartLintMessage) GetPath() string {
 	if x != nil {
-		return x.RepoUrl
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ChartSpec) GetValues() map[string]string {
+func (x *ChartLintMessage) GetMessage() string {
 	if x != nil {
-		return x.Values
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// ========== 升级请求/响应 ==========
-type UpgradeChartRequest struct {
+type UploadChartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Chart         *ChartSpec             `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
-	Force         bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                   // 是否强制升级（--force）
-	RecreatePods  bool                   `protobuf:"varint,5,opt,name=recreate_pods,json=recreatePods,proto3" json:"recreate_pods,omitempty"` // 是否重启 Pod（--recreate-pods）
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	UploadId      string                 `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Received      int64                  `protobuf:"varint,5,opt,name=received,proto3" json:"received,omitempty"`   // 服务端已接收的字节数，未完成时客户端从这里续传
+	Completed     bool                   `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"` // 文件是否接收完整并已推送
+	ChartUrl      string                 `protobuf:"bytes,7,opt,name=chart_url,json=chartUrl,proto3" json:"chart_url,omitempty"`
+	ChartName     string                 `protobuf:"bytes,8,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
+	ChartVersion  string                 `protobuf:"bytes,9,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,10,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Digest        string                 `protobuf:"bytes,11,opt,name=digest,proto3" json:"digest,omitempty"`                     // sha256:<hex>
+	SignedBy      string                 `protobuf:"bytes,12,opt,name=signed_by,json=signedBy,proto3" json:"signed_by,omitempty"` // 提供 provenance 时的签名者
+	Lint          []*ChartLintMessage    `protobuf:"bytes,13,rep,name=lint,proto3" json:"lint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeChartRequest) Reset() {
-	*x = UpgradeChartRequest{}
-	mi := &file_helm_service_proto_msgTypes[26]
+func (x *UploadChartResponse) Reset() {
+	*x = UploadChartResponse{}
+	mi := &file_helm_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeChartRequest) String() string {
+func (x *UploadChartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeChartRequest) ProtoMessage() {}
+func (*UploadChartResponse) ProtoMessage() {}
 
-func (x *UpgradeChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[26]
+func (x *UploadChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,124 +5576,129 @@ func (x *UpgradeChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeChartRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeChartRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UploadChartResponse.ProtoReflect.Descriptor instead.
+func (*UploadChartResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpgradeChartRequest) GetNamespace() string {
+func (x *UploadChartResponse) GetCode() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *UpgradeChartRequest) GetReleaseName() string {
+func (x *UploadChartResponse) GetMessage() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpgradeChartRequest) GetChart() *ChartSpec {
+func (x *UploadChartResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Chart
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *UpgradeChartRequest) GetForce() bool {
+func (x *UploadChartResponse) GetUploadId() string {
 	if x != nil {
-		return x.Force
+		return x.UploadId
 	}
-	return false
+	return ""
 }
 
-func (x *UpgradeChartRequest) GetRecreatePods() bool {
+func (x *UploadChartResponse) GetReceived() int64 {
 	if x != nil {
-		return x.RecreatePods
+		return x.Received
 	}
-	return false
+	return 0
 }
 
-type UpgradeChartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Revision      string                 `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"` // 新版本号（如 "2"）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UploadChartResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
 }
 
-func (x *UpgradeChartResponse) Reset() {
-	*x = UpgradeChartResponse{}
-	mi := &file_helm_service_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UploadChartResponse) GetChartUrl() string {
+	if x != nil {
+		return x.ChartUrl
+	}
+	return ""
 }
 
-func (x *UpgradeChartResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UploadChartResponse) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
 }
 
-func (*UpgradeChartResponse) ProtoMessage() {}
-
-func (x *UpgradeChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[27]
+func (x *UploadChartResponse) GetChartVersion() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChartVersion
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpgradeChartResponse.ProtoReflect.Descriptor instead.
-func (*UpgradeChartResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{27}
+func (x *UploadChartResponse) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
 }
 
-func (x *UpgradeChartResponse) GetStatus() string {
+func (x *UploadChartResponse) GetDigest() string {
 	if x != nil {
-		return x.Status
+		return x.Digest
 	}
 	return ""
 }
 
-func (x *UpgradeChartResponse) GetRevision() string {
+func (x *UploadChartResponse) GetSignedBy() string {
 	if x != nil {
-		return x.Revision
+		return x.SignedBy
 	}
 	return ""
 }
 
-// ========== 回滚请求/响应 ==========
-type RollbackChartRequest struct {
+func (x *UploadChartResponse) GetLint() []*ChartLintMessage {
+	if x != nil {
+		return x.Lint
+	}
+	return nil
+}
+
+// 27. chart 依赖树
+type GetChartDependenciesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Revision      string                 `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"` // 目标回滚版本号（如 "1"）
-	Wait          bool                   `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`        // 是否等待回滚完成（--wait）
+	RepoName      string                 `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	ChartName     string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                         // 为空时使用最新的稳定版本
+	ValuesYaml    string                 `protobuf:"bytes,4,opt,name=values_yaml,json=valuesYaml,proto3" json:"values_yaml,omitempty"` // 用于计算 condition/tags 的 values 文档（YAML 或 JSON）
+	Set           []string               `protobuf:"bytes,5,rep,name=set,proto3" json:"set,omitempty"`                                 // 与 helm --set 相同，优先级高于 values_yaml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackChartRequest) Reset() {
-	*x = RollbackChartRequest{}
-	mi := &file_helm_service_proto_msgTypes[28]
+func (x *GetChartDependenciesRequest) Reset() {
+	*x = GetChartDependenciesRequest{}
+	mi := &file_helm_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackChartRequest) String() string {
+func (x *GetChartDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackChartRequest) ProtoMessage() {}
+func (*GetChartDependenciesRequest) ProtoMessage() {}
 
-func (x *RollbackChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[28]
+func (x *GetChartDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,62 +5709,79 @@ func (x *RollbackChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackChartRequest.ProtoReflect.Descriptor instead.
-func (*RollbackChartRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetChartDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetChartDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RollbackChartRequest) GetNamespace() string {
+func (x *GetChartDependenciesRequest) GetRepoName() string {
 	if x != nil {
-		return x.Namespace
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *RollbackChartRequest) GetReleaseName() string {
+func (x *GetChartDependenciesRequest) GetChartName() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.ChartName
 	}
 	return ""
 }
 
-func (x *RollbackChartRequest) GetRevision() string {
+func (x *GetChartDependenciesRequest) GetVersion() string {
 	if x != nil {
-		return x.Revision
+		return x.Version
 	}
 	return ""
 }
 
-func (x *RollbackChartRequest) GetWait() bool {
+func (x *GetChartDependenciesRequest) GetValuesYaml() string {
 	if x != nil {
-		return x.Wait
+		return x.ValuesYaml
 	}
-	return false
+	return ""
 }
 
-type RollbackChartResponse struct {
+func (x *GetChartDependenciesRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+type DependencyNode struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentRevision string                 `protobuf:"bytes,2,opt,name=current_revision,json=currentRevision,proto3" json:"current_revision,omitempty"` // 回滚后的版本号
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias           string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Version         string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                        // Chart.yaml 中声明的版本约束
+	ResolvedVersion string                 `protobuf:"bytes,4,opt,name=resolved_version,json=resolvedVersion,proto3" json:"resolved_version,omitempty"` // 实际使用的版本，缺失时为空
+	Repository      string                 `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
+	Condition       string                 `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Enabled         bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`           // 按 values 计算 condition/tags 后是否启用
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`              // 启用或禁用的依据，如 condition redis.enabled=false
+	Vendored        bool                   `protobuf:"varint,10,opt,name=vendored,proto3" json:"vendored,omitempty"`        // 是否已打包在 charts/ 目录中，否则为从仓库下载
+	Missing         bool                   `protobuf:"varint,11,opt,name=missing,proto3" json:"missing,omitempty"`          // charts/ 中缺少且未能下载
+	Dependencies    []*DependencyNode      `protobuf:"bytes,12,rep,name=dependencies,proto3" json:"dependencies,omitempty"` // 子 chart 自身的依赖
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RollbackChartResponse) Reset() {
-	*x = RollbackChartResponse{}
-	mi := &file_helm_service_proto_msgTypes[29]
+func (x *DependencyNode) Reset() {
+	*x = DependencyNode{}
+	mi := &file_helm_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackChartResponse) String() string {
+func (x *DependencyNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackChartResponse) ProtoMessage() {}
+func (*DependencyNode) ProtoMessage() {}
 
-func (x *RollbackChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[29]
+func (x *DependencyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1946,111 +5792,123 @@ func (x *RollbackChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackChartResponse.ProtoReflect.Descriptor instead.
-func (*RollbackChartResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DependencyNode.ProtoReflect.Descriptor instead.
+func (*DependencyNode) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *RollbackChartResponse) GetStatus() string {
+func (x *DependencyNode) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RollbackChartResponse) GetCurrentRevision() string {
+func (x *DependencyNode) GetAlias() string {
 	if x != nil {
-		return x.CurrentRevision
+		return x.Alias
 	}
 	return ""
 }
 
-// ========== 请求/响应定义 ==========
-type ListChartVersionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepoName      string                 `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`           // 仓库名称（如 "stable"）
-	ChartName     string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`        // Chart 名称（如 "nginx"）
-	WithDetails   bool                   `protobuf:"varint,3,opt,name=with_details,json=withDetails,proto3" json:"with_details,omitempty"` // 是否返回详细元数据（如更新时间）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DependencyNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *ListChartVersionsRequest) Reset() {
-	*x = ListChartVersionsRequest{}
-	mi := &file_helm_service_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DependencyNode) GetResolvedVersion() string {
+	if x != nil {
+		return x.ResolvedVersion
+	}
+	return ""
 }
 
-func (x *ListChartVersionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DependencyNode) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
 }
 
-func (*ListChartVersionsRequest) ProtoMessage() {}
+func (x *DependencyNode) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
 
-func (x *ListChartVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[30]
+func (x *DependencyNode) GetTags() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Tags
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListChartVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListChartVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{30}
+func (x *DependencyNode) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-func (x *ListChartVersionsRequest) GetRepoName() string {
+func (x *DependencyNode) GetReason() string {
 	if x != nil {
-		return x.RepoName
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ListChartVersionsRequest) GetChartName() string {
+func (x *DependencyNode) GetVendored() bool {
 	if x != nil {
-		return x.ChartName
+		return x.Vendored
 	}
-	return ""
+	return false
 }
 
-func (x *ListChartVersionsRequest) GetWithDetails() bool {
+func (x *DependencyNode) GetMissing() bool {
 	if x != nil {
-		return x.WithDetails
+		return x.Missing
 	}
 	return false
 }
 
-type ChartVersionInfo struct {
+func (x *DependencyNode) GetDependencies() []*DependencyNode {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type GetChartDependenciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                         // 版本号（如 "1.2.3"）
-	AppVersion    string                 `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"` // 应用版本（如 "1.20.0"）
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // 版本描述
-	Created       string                 `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`                         // 创建时间（RFC3339 格式）
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	ChartName     string                 `protobuf:"bytes,4,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
+	ChartVersion  string                 `protobuf:"bytes,5,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	Locked        bool                   `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"` // 是否包含 Chart.lock
+	Dependencies  []*DependencyNode      `protobuf:"bytes,7,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChartVersionInfo) Reset() {
-	*x = ChartVersionInfo{}
-	mi := &file_helm_service_proto_msgTypes[31]
+func (x *GetChartDependenciesResponse) Reset() {
+	*x = GetChartDependenciesResponse{}
+	mi := &file_helm_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChartVersionInfo) String() string {
+func (x *GetChartDependenciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChartVersionInfo) ProtoMessage() {}
+func (*GetChartDependenciesResponse) ProtoMessage() {}
 
-func (x *ChartVersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[31]
+func (x *GetChartDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,62 +5919,84 @@ func (x *ChartVersionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChartVersionInfo.ProtoReflect.Descriptor instead.
-func (*ChartVersionInfo) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetChartDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetChartDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ChartVersionInfo) GetVersion() string {
+func (x *GetChartDependenciesResponse) GetCode() int32 {
 	if x != nil {
-		return x.Version
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *ChartVersionInfo) GetAppVersion() string {
+func (x *GetChartDependenciesResponse) GetMessage() string {
 	if x != nil {
-		return x.AppVersion
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ChartVersionInfo) GetDescription() string {
+func (x *GetChartDependenciesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Description
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetChartDependenciesResponse) GetChartName() string {
+	if x != nil {
+		return x.ChartName
 	}
 	return ""
 }
 
-func (x *ChartVersionInfo) GetCreated() string {
+func (x *GetChartDependenciesResponse) GetChartVersion() string {
 	if x != nil {
-		return x.Created
+		return x.ChartVersion
 	}
 	return ""
 }
 
-type ListChartVersionsResponse struct {
+func (x *GetChartDependenciesResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *GetChartDependenciesResponse) GetDependencies() []*DependencyNode {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// 28. 权限检查（can-i）
+type CheckAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Versions      []*ChartVersionInfo    `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
-	RepoUrl       string                 `protobuf:"bytes,2,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"` // 仓库地址（如 "https://charts.helm.sh/stable"）
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`       // 完整方法名如 /helm.v1alpha1.HelmManagerService/InstallChart，或只写 InstallChart
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // 为空表示集群范围或全部命名空间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChartVersionsResponse) Reset() {
-	*x = ListChartVersionsResponse{}
-	mi := &file_helm_service_proto_msgTypes[32]
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_helm_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChartVersionsResponse) String() string {
+func (x *CheckAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChartVersionsResponse) ProtoMessage() {}
+func (*CheckAccessRequest) ProtoMessage() {}
 
-func (x *ListChartVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[32]
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,50 +6007,55 @@ func (x *ListChartVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChartVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListChartVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListChartVersionsResponse) GetVersions() []*ChartVersionInfo {
+func (x *CheckAccessRequest) GetMethod() string {
 	if x != nil {
-		return x.Versions
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *ListChartVersionsResponse) GetRepoUrl() string {
+func (x *CheckAccessRequest) GetNamespace() string {
 	if x != nil {
-		return x.RepoUrl
+		return x.Namespace
 	}
 	return ""
 }
 
-type ListInstalledChartsRequest struct {
+type CheckAccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // 目标命名空间（空表示所有命名空间）
-	ReleaseName   string                 `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	WithStatus    bool                   `protobuf:"varint,3,opt,name=with_status,json=withStatus,proto3" json:"with_status,omitempty"`       // 是否包含状态信息
-	WithManifest  bool                   `protobuf:"varint,4,opt,name=with_manifest,json=withManifest,proto3" json:"with_manifest,omitempty"` // 是否包含资源清单
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Allowed       bool                   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // 允许或拒绝的依据
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"` // 解析后的完整方法名
+	Role          string                 `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`     // 授权的角色，拒绝时为空
+	UserId        string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,9,rep,name=roles,proto3" json:"roles,omitempty"` // 调用方在 token 中的角色
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInstalledChartsRequest) Reset() {
-	*x = ListInstalledChartsRequest{}
-	mi := &file_helm_service_proto_msgTypes[33]
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	mi := &file_helm_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstalledChartsRequest) String() string {
+func (x *CheckAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstalledChartsRequest) ProtoMessage() {}
+func (*CheckAccessResponse) ProtoMessage() {}
 
-func (x *ListInstalledChartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[33]
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2181,64 +6066,100 @@ func (x *ListInstalledChartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstalledChartsRequest.ProtoReflect.Descriptor instead.
-func (*ListInstalledChartsRequest) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ListInstalledChartsRequest) GetNamespace() string {
+func (x *CheckAccessResponse) GetCode() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *ListInstalledChartsRequest) GetReleaseName() string {
+func (x *CheckAccessResponse) GetMessage() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListInstalledChartsRequest) GetWithStatus() bool {
+func (x *CheckAccessResponse) GetSuccess() bool {
 	if x != nil {
-		return x.WithStatus
+		return x.Success
 	}
 	return false
 }
 
-func (x *ListInstalledChartsRequest) GetWithManifest() bool {
+func (x *CheckAccessResponse) GetAllowed() bool {
 	if x != nil {
-		return x.WithManifest
+		return x.Allowed
 	}
 	return false
 }
 
-type ListInstalledChartsResponse struct {
+func (x *CheckAccessResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 29. 工作空间命名空间
+type EnsureWorkspaceNamespaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Data          []*anypb.Any           `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	WorkspaceId   uint64                 `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ProjectId     uint64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EnvId         uint64                 `protobuf:"varint,3,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	AppId         uint64                 `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // 指定应用时使用 jos_app 中的工作空间、项目和环境
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInstalledChartsResponse) Reset() {
-	*x = ListInstalledChartsResponse{}
-	mi := &file_helm_service_proto_msgTypes[34]
+func (x *EnsureWorkspaceNamespaceRequest) Reset() {
+	*x = EnsureWorkspaceNamespaceRequest{}
+	mi := &file_helm_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstalledChartsResponse) String() string {
+func (x *EnsureWorkspaceNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstalledChartsResponse) ProtoMessage() {}
+func (*EnsureWorkspaceNamespaceRequest) ProtoMessage() {}
 
-func (x *ListInstalledChartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[34]
+func (x *EnsureWorkspaceNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2249,69 +6170,65 @@ func (x *ListInstalledChartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstalledChartsResponse.ProtoReflect.Descriptor instead.
-func (*ListInstalledChartsResponse) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use EnsureWorkspaceNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*EnsureWorkspaceNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListInstalledChartsResponse) GetCode() int32 {
+func (x *EnsureWorkspaceNamespaceRequest) GetWorkspaceId() uint64 {
 	if x != nil {
-		return x.Code
+		return x.WorkspaceId
 	}
 	return 0
 }
 
-func (x *ListInstalledChartsResponse) GetMessage() string {
+func (x *EnsureWorkspaceNamespaceRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ProjectId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListInstalledChartsResponse) GetSuccess() bool {
+func (x *EnsureWorkspaceNamespaceRequest) GetEnvId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.EnvId
 	}
-	return false
+	return 0
 }
 
-func (x *ListInstalledChartsResponse) GetData() []*anypb.Any {
+func (x *EnsureWorkspaceNamespaceRequest) GetAppId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.AppId
 	}
-	return nil
+	return 0
 }
 
-type InstalledChart struct {
+// 工作空间/项目/环境，用于在安装类接口中代替 namespace 指定托管命名空间
+type WorkspaceScope struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Release 名称
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                           // 所属命名空间
-	ChartName     string                 `protobuf:"bytes,3,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`          // Chart 名称（如 nginx）
-	ChartVersion  string                 `protobuf:"bytes,4,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"` // Chart 版本（如 1.2.3）
-	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`       // 应用版本
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                 // 状态（DEPLOYED/FAILED/PENDING）
-	Manifest      string                 `protobuf:"bytes,7,opt,name=manifest,proto3" json:"manifest,omitempty"`
-	Updated       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`                                                                         // 最后更新时间
-	Values        map[string]string      `protobuf:"bytes,9,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户自定义 values
+	WorkspaceId   uint64                 `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ProjectId     uint64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EnvId         uint64                 `protobuf:"varint,3,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	AppId         uint64                 `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"` // 指定应用时使用 jos_app 中的工作空间、项目和环境
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstalledChart) Reset() {
-	*x = InstalledChart{}
-	mi := &file_helm_service_proto_msgTypes[35]
+func (x *WorkspaceScope) Reset() {
+	*x = WorkspaceScope{}
+	mi := &file_helm_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstalledChart) String() string {
+func (x *WorkspaceScope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledChart) ProtoMessage() {}
+func (*WorkspaceScope) ProtoMessage() {}
 
-func (x *InstalledChart) ProtoReflect() protoreflect.Message {
-	mi := &file_helm_service_proto_msgTypes[35]
+func (x *WorkspaceScope) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2322,84 +6239,126 @@ func (x *InstalledChart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledChart.ProtoReflect.Descriptor instead.
-func (*InstalledChart) Descriptor() ([]byte, []int) {
-	return file_helm_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use WorkspaceScope.ProtoReflect.Descriptor instead.
+func (*WorkspaceScope) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *InstalledChart) GetName() string {
+func (x *WorkspaceScope) GetWorkspaceId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.WorkspaceId
 	}
-	return ""
+	return 0
 }
 
-func (x *InstalledChart) GetNamespace() string {
+func (x *WorkspaceScope) GetProjectId() uint64 {
 	if x != nil {
-		return x.Namespace
+		return x.ProjectId
 	}
-	return ""
+	return 0
 }
 
-func (x *InstalledChart) GetChartName() string {
+func (x *WorkspaceScope) GetEnvId() uint64 {
 	if x != nil {
-		return x.ChartName
+		return x.EnvId
 	}
-	return ""
+	return 0
 }
 
-func (x *InstalledChart) GetChartVersion() string {
+func (x *WorkspaceScope) GetAppId() uint64 {
 	if x != nil {
-		return x.ChartVersion
+		return x.AppId
 	}
-	return ""
+	return 0
 }
 
-func (x *InstalledChart) GetAppVersion() string {
+type EnsureWorkspaceNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Created       bool                   `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"` // 本次调用是否新建了命名空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnsureWorkspaceNamespaceResponse) Reset() {
+	*x = EnsureWorkspaceNamespaceResponse{}
+	mi := &file_helm_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnsureWorkspaceNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureWorkspaceNamespaceResponse) ProtoMessage() {}
+
+func (x *EnsureWorkspaceNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_helm_service_proto_msgTypes[80]
 	if x != nil {
-		return x.AppVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledChart) GetStatus() string {
+// Deprecated: Use EnsureWorkspaceNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*EnsureWorkspaceNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_helm_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *EnsureWorkspaceNamespaceResponse) GetCode() int32 {
 	if x != nil {
-		return x.Status
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *InstalledChart) GetManifest() string {
+func (x *EnsureWorkspaceNamespaceResponse) GetMessage() string {
 	if x != nil {
-		return x.Manifest
+		return x.Message
 	}
 	return ""
 }
 
-func (x *InstalledChart) GetUpdated() *timestamppb.Timestamp {
+func (x *EnsureWorkspaceNamespaceResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Updated
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *InstalledChart) GetValues() map[string]string {
+func (x *EnsureWorkspaceNamespaceResponse) GetNamespace() string {
 	if x != nil {
-		return x.Values
+		return x.Namespace
 	}
-	return nil
+	return ""
+}
+
+func (x *EnsureWorkspaceNamespaceResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
 }
 
 var File_helm_service_proto protoreflect.FileDescriptor
 
 const file_helm_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12helm_service.proto\x12\rhelm.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\x12helm_service.proto\x12\rhelm.v1alpha1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\x01\n" +
 	"\x11ListChartsRequest\x12\x1b\n" +
 	"\trepo_name\x18\x01 \x01(\tR\brepoName\x12\x18\n" +
 	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04size\x18\x04 \x01(\x05R\x04size\"\xe2\x01\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\"\x9a\x02\n" +
 	"\tChartInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
 	"\rchart_version\x18\x02 \x01(\tR\fchartVersion\x12\x19\n" +
@@ -2412,7 +6371,9 @@ const file_helm_service_proto_rawDesc = "" +
 	"updateDate\x12\x1e\n" +
 	"\n" +
 	"updateUser\x18\a \x01(\tR\n" +
-	"updateUser\"\xb7\x01\n" +
+	"updateUser\x12\x1a\n" +
+	"\bversions\x18\b \x03(\tR\bversions\x12\x1a\n" +
+	"\bkeywords\x18\t \x03(\tR\bkeywords\"\xb7\x01\n" +
 	"\x0eListChartsData\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -2424,19 +6385,104 @@ const file_helm_service_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
 	"\asuccess\x18\x03 \x01(\bR\asuccess\x12(\n" +
-	"\x04data\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\x04data\"\x8d\x01\n" +
+	"\x04data\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\x04data\"\xf8\x01\n" +
 	"\x14ConfigureRepoRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x17\n" +
-	"\aca_file\x18\x05 \x01(\tR\x06caFile\"1\n" +
+	"\aca_file\x18\x05 \x01(\tR\x06caFile\x127\n" +
+	"\x18insecure_skip_tls_verify\x18\x06 \x01(\bR\x15insecureSkipTlsVerify\x120\n" +
+	"\x14pass_credentials_all\x18\a \x01(\bR\x12passCredentialsAll\"\x8c\x01\n" +
 	"\x15ConfigureRepoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"9\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.helm.v1alpha1.RepoInfoR\x04data\"\xd0\x01\n" +
+	"\bRepoInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x17\n" +
+	"\aca_file\x18\x04 \x01(\tR\x06caFile\x127\n" +
+	"\x18insecure_skip_tls_verify\x18\x05 \x01(\bR\x15insecureSkipTlsVerify\x120\n" +
+	"\x14pass_credentials_all\x18\x06 \x01(\bR\x12passCredentialsAll\"\x12\n" +
+	"\x10ListReposRequest\"\x88\x01\n" +
+	"\x11ListReposResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12+\n" +
+	"\x04data\x18\x04 \x03(\v2\x17.helm.v1alpha1.RepoInfoR\x04data\"'\n" +
+	"\x11RemoveRepoRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\\\n" +
+	"\x12RemoveRepoResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"R\n" +
+	"\x14ListChartTagsRequest\x12\x1b\n" +
+	"\trepo_name\x18\x01 \x01(\tR\brepoName\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x02 \x01(\tR\tchartName\"s\n" +
+	"\x15ListChartTagsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"n\n" +
+	"\x16GetChartDetailsRequest\x12\x1b\n" +
+	"\trepo_name\x18\x01 \x01(\tR\brepoName\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x02 \x01(\tR\tchartName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"M\n" +
+	"\x0fChartMaintainer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xc3\x01\n" +
+	"\x0fChartDependency\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x03 \x01(\tR\n" +
+	"repository\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05alias\x18\x06 \x01(\tR\x05alias\x12\x1a\n" +
+	"\bvendored\x18\a \x01(\bR\bvendored\"\xd8\x05\n" +
+	"\fChartDetails\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
+	"\vapp_version\x18\x03 \x01(\tR\n" +
+	"appVersion\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vapi_version\x18\x05 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12!\n" +
+	"\fkube_version\x18\a \x01(\tR\vkubeVersion\x12\x12\n" +
+	"\x04home\x18\b \x01(\tR\x04home\x12\x12\n" +
+	"\x04icon\x18\t \x01(\tR\x04icon\x12\x1a\n" +
+	"\bkeywords\x18\n" +
+	" \x03(\tR\bkeywords\x12\x18\n" +
+	"\asources\x18\v \x03(\tR\asources\x12@\n" +
+	"\vmaintainers\x18\f \x03(\v2\x1e.helm.v1alpha1.ChartMaintainerR\vmaintainers\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\r \x01(\bR\n" +
+	"deprecated\x12N\n" +
+	"\vannotations\x18\x0e \x03(\v2,.helm.v1alpha1.ChartDetails.AnnotationsEntryR\vannotations\x12\x16\n" +
+	"\x06values\x18\x0f \x01(\tR\x06values\x12#\n" +
+	"\rvalues_schema\x18\x10 \x01(\tR\fvaluesSchema\x12\x16\n" +
+	"\x06readme\x18\x11 \x01(\tR\x06readme\x12B\n" +
+	"\fdependencies\x18\x12 \x03(\v2\x1e.helm.v1alpha1.ChartDependencyR\fdependencies\x12\x16\n" +
+	"\x06digest\x18\x13 \x01(\tR\x06digest\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x01\n" +
+	"\x17GetChartDetailsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12/\n" +
+	"\x04data\x18\x04 \x01(\v2\x1b.helm.v1alpha1.ChartDetailsR\x04data\"9\n" +
 	"\tK8sObject\x12,\n" +
 	"\x06object\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\x06object\"?\n" +
 	"\rK8sObjectList\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.helm.v1alpha1.K8sObjectR\x05items\"\xce\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.helm.v1alpha1.K8sObjectR\x05items\"\xa0\x02\n" +
 	"\x13InstallChartRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x18\n" +
@@ -2444,7 +6490,9 @@ const file_helm_service_proto_rawDesc = "" +
 	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x17\n" +
 	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x16\n" +
 	"\x06values\x18\x06 \x01(\tR\x06values\x12\x17\n" +
-	"\auser_id\x18\a \x01(\tR\x06userId\"\x8b\x03\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12\x1b\n" +
+	"\trepo_name\x18\b \x01(\tR\brepoName\x123\n" +
+	"\x05scope\x18\t \x01(\v2\x1d.helm.v1alpha1.WorkspaceScopeR\x05scope\"\x99\x04\n" +
 	"\x14InstallChartResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12%\n" +
@@ -2453,21 +6501,44 @@ const file_helm_service_proto_rawDesc = "" +
 	"\adeleted\x18\x05 \x01(\tR\adeleted\x12\x18\n" +
 	"\amessage\x18\x06 \x01(\tR\amessage\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x12J\n" +
-	"\aentries\x18\b \x03(\v20.helm.v1alpha1.InstallChartResponse.EntriesEntryR\aentries\x1aX\n" +
+	"\aentries\x18\b \x03(\v20.helm.v1alpha1.InstallChartResponse.EntriesEntryR\aentries\x12!\n" +
+	"\foperation_id\x18\t \x01(\tR\voperationId\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x12S\n" +
+	"\x11validation_errors\x18\v \x03(\v2&.helm.v1alpha1.ResourceValidationErrorR\x10validationErrors\x1aX\n" +
 	"\fEntriesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
-	"\x05value\x18\x02 \x01(\v2\x1c.helm.v1alpha1.K8sObjectListR\x05value:\x028\x01\"\xf7\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.helm.v1alpha1.K8sObjectListR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x17ResourceValidationError\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x83\x03\n" +
 	"\x15UninstallChartRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x14\n" +
 	"\x05purge\x18\x03 \x01(\bR\x05purge\x12K\n" +
-	"\aoptions\x18\x04 \x03(\v21.helm.v1alpha1.UninstallChartRequest.OptionsEntryR\aoptions\x1a:\n" +
+	"\aoptions\x18\x04 \x03(\v21.helm.v1alpha1.UninstallChartRequest.OptionsEntryR\aoptions\x12!\n" +
+	"\fkeep_history\x18\x05 \x01(\bR\vkeepHistory\x12\x1f\n" +
+	"\vdelete_pvcs\x18\x06 \x01(\bR\n" +
+	"deletePvcs\x12#\n" +
+	"\rcleanup_kinds\x18\a \x03(\tR\fcleanupKinds\x12!\n" +
+	"\fskip_cleanup\x18\b \x01(\bR\vskipCleanup\x1a:\n" +
 	"\fOptionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x10ResourceDeletion\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xa8\x01\n" +
 	"\x16UninstallChartResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\\\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\tR\voperationId\x12=\n" +
+	"\tdeletions\x18\x04 \x03(\v2\x1f.helm.v1alpha1.ResourceDeletionR\tdeletions\"\\\n" +
 	"\x19WatchInstallStatusRequest\x12!\n" +
 	"\frelease_name\x18\x01 \x01(\tR\vreleaseName\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"?\n" +
@@ -2506,18 +6577,50 @@ const file_helm_service_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
 	"\asuccess\x18\x03 \x01(\bR\asuccess\x121\n" +
-	"\x04data\x18\x04 \x01(\v2\x1d.helm.v1alpha1.PodsStatusListR\x04data\"Z\n" +
+	"\x04data\x18\x04 \x01(\v2\x1d.helm.v1alpha1.PodsStatusListR\x04data\"]\n" +
+	"\x1aGetReleaseResourcesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\"\x91\x03\n" +
+	"\fResourceNode\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06health\x18\x05 \x01(\tR\x06health\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x14\n" +
+	"\x05ready\x18\a \x01(\tR\x05ready\x12\x1c\n" +
+	"\tendpoints\x18\b \x03(\tR\tendpoints\x12\x14\n" +
+	"\x05hosts\x18\t \x03(\tR\x05hosts\x12#\n" +
+	"\rload_balancer\x18\n" +
+	" \x03(\tR\floadBalancer\x12\x14\n" +
+	"\x05phase\x18\v \x01(\tR\x05phase\x12*\n" +
+	"\x03pod\x18\f \x01(\v2\x18.helm.v1alpha1.PodStatusR\x03pod\x127\n" +
+	"\bchildren\x18\r \x03(\v2\x1b.helm.v1alpha1.ResourceNodeR\bchildren\"\xda\x01\n" +
+	"\x10ReleaseResources\x12!\n" +
+	"\frelease_name\x18\x01 \x01(\tR\vreleaseName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06health\x18\x05 \x01(\tR\x06health\x129\n" +
+	"\tresources\x18\x06 \x03(\v2\x1b.helm.v1alpha1.ResourceNodeR\tresources\"\x9a\x01\n" +
+	"\x1bGetReleaseResourcesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x123\n" +
+	"\x04data\x18\x04 \x01(\v2\x1f.helm.v1alpha1.ReleaseResourcesR\x04data\"Z\n" +
 	"\x17CheckApisixRouteRequest\x12!\n" +
 	"\frelease_name\x18\x01 \x01(\tR\vreleaseName\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"O\n" +
 	"\x18CheckApisixRouteResponse\x12\x16\n" +
 	"\x06exists\x18\x01 \x01(\bR\x06exists\x12\x1b\n" +
-	"\troute_url\x18\x02 \x01(\tR\brouteUrl\"\x86\x01\n" +
+	"\troute_url\x18\x02 \x01(\tR\brouteUrl\"\xbb\x01\n" +
 	"\x1dCreateChartApplicationRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
 	"\tchart_ref\x18\x03 \x01(\tR\bchartRef\x12\x16\n" +
-	"\x06values\x18\x04 \x01(\tR\x06values\":\n" +
+	"\x06values\x18\x04 \x01(\tR\x06values\x123\n" +
+	"\x05scope\x18\x05 \x01(\v2\x1d.helm.v1alpha1.WorkspaceScopeR\x05scope\":\n" +
 	"\x1eCreateChartApplicationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"p\n" +
 	"\x17CheckPodTerminalRequest\x12\x1c\n" +
@@ -2526,58 +6629,143 @@ const file_helm_service_proto_rawDesc = "" +
 	"\tcontainer\x18\x03 \x01(\tR\tcontainer\"]\n" +
 	"\x18CheckPodTerminalResponse\x12\x1c\n" +
 	"\tsupported\x18\x01 \x01(\bR\tsupported\x12#\n" +
-	"\rwebsocket_url\x18\x02 \x01(\tR\fwebsocketUrl\"\xe3\x01\n" +
+	"\rwebsocket_url\x18\x02 \x01(\tR\fwebsocketUrl\"\xd2\x02\n" +
 	"\tChartSpec\x12\x1d\n" +
 	"\n" +
 	"chart_name\x18\x01 \x01(\tR\tchartName\x12#\n" +
 	"\rchart_version\x18\x02 \x01(\tR\fchartVersion\x12\x19\n" +
 	"\brepo_url\x18\x03 \x01(\tR\arepoUrl\x12<\n" +
-	"\x06values\x18\x04 \x03(\v2$.helm.v1alpha1.ChartSpec.ValuesEntryR\x06values\x1a9\n" +
+	"\x06values\x18\x04 \x03(\v2$.helm.v1alpha1.ChartSpec.ValuesEntryR\x06values\x12\x1b\n" +
+	"\trepo_name\x18\x05 \x01(\tR\brepoName\x12\x1f\n" +
+	"\vvalues_yaml\x18\x06 \x01(\tR\n" +
+	"valuesYaml\x12\x10\n" +
+	"\x03set\x18\a \x03(\tR\x03set\x12\x1d\n" +
+	"\n" +
+	"set_string\x18\b \x03(\tR\tsetString\x1a9\n" +
 	"\vValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x02\n" +
 	"\x13UpgradeChartRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12.\n" +
 	"\x05chart\x18\x03 \x01(\v2\x18.helm.v1alpha1.ChartSpecR\x05chart\x12\x14\n" +
 	"\x05force\x18\x04 \x01(\bR\x05force\x12#\n" +
-	"\rrecreate_pods\x18\x05 \x01(\bR\frecreatePods\"J\n" +
+	"\rrecreate_pods\x18\x05 \x01(\bR\frecreatePods\x12!\n" +
+	"\freuse_values\x18\x06 \x01(\bR\vreuseValues\x12!\n" +
+	"\freset_values\x18\a \x01(\bR\vresetValues\x125\n" +
+	"\x17reset_then_reuse_values\x18\b \x01(\bR\x14resetThenReuseValues\"m\n" +
 	"\x14UpgradeChartResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
-	"\brevision\x18\x02 \x01(\tR\brevision\"\x87\x01\n" +
+	"\brevision\x18\x02 \x01(\tR\brevision\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\tR\voperationId\"\xca\x02\n" +
+	"\x12DiffReleaseRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12.\n" +
+	"\x05chart\x18\x03 \x01(\v2\x18.helm.v1alpha1.ChartSpecR\x05chart\x12!\n" +
+	"\fcompare_live\x18\x04 \x01(\bR\vcompareLive\x12#\n" +
+	"\rcontext_lines\x18\x05 \x01(\x05R\fcontextLines\x12!\n" +
+	"\freuse_values\x18\x06 \x01(\bR\vreuseValues\x12!\n" +
+	"\freset_values\x18\a \x01(\bR\vresetValues\x125\n" +
+	"\x17reset_then_reuse_values\x18\b \x01(\bR\x14resetThenReuseValues\"\x96\x02\n" +
+	"\fResourceDiff\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06change\x18\x05 \x01(\tR\x06change\x12\x12\n" +
+	"\x04diff\x18\x06 \x01(\tR\x04diff\x12\x1b\n" +
+	"\tlive_diff\x18\a \x01(\tR\bliveDiff\x12)\n" +
+	"\x10immutable_fields\x18\b \x03(\tR\x0fimmutableFields\x12+\n" +
+	"\x11requires_recreate\x18\t \x01(\bR\x10requiresRecreate\"\x8b\x02\n" +
+	"\x13DiffReleaseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12)\n" +
+	"\x10current_revision\x18\x04 \x01(\x05R\x0fcurrentRevision\x12#\n" +
+	"\rcurrent_chart\x18\x05 \x01(\tR\fcurrentChart\x12!\n" +
+	"\ftarget_chart\x18\x06 \x01(\tR\vtargetChart\x129\n" +
+	"\tresources\x18\a \x03(\v2\x1b.helm.v1alpha1.ResourceDiffR\tresources\"\xdf\x01\n" +
 	"\x14RollbackChartRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x1a\n" +
 	"\brevision\x18\x03 \x01(\tR\brevision\x12\x12\n" +
-	"\x04wait\x18\x04 \x01(\bR\x04wait\"Z\n" +
+	"\x04wait\x18\x04 \x01(\bR\x04wait\x12\x18\n" +
+	"\atimeout\x18\x05 \x01(\tR\atimeout\x12&\n" +
+	"\x0fcleanup_on_fail\x18\x06 \x01(\bR\rcleanupOnFail\x12\x14\n" +
+	"\x05force\x18\a \x01(\bR\x05force\"\xc4\x01\n" +
 	"\x15RollbackChartResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12)\n" +
-	"\x10current_revision\x18\x02 \x01(\tR\x0fcurrentRevision\"y\n" +
+	"\x10current_revision\x18\x02 \x01(\tR\x0fcurrentRevision\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\tR\voperationId\x12#\n" +
+	"\rchart_version\x18\x04 \x01(\tR\fchartVersion\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"m\n" +
+	"\x18GetReleaseHistoryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x05R\x03max\"\xa9\x02\n" +
+	"\x0fReleaseRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05chart\x18\x03 \x01(\tR\x05chart\x12#\n" +
+	"\rchart_version\x18\x04 \x01(\tR\fchartVersion\x12\x1f\n" +
+	"\vapp_version\x18\x05 \x01(\tR\n" +
+	"appVersion\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12%\n" +
+	"\x0efirst_deployed\x18\a \x01(\tR\rfirstDeployed\x12#\n" +
+	"\rlast_deployed\x18\b \x01(\tR\flastDeployed\x12\x18\n" +
+	"\adeleted\x18\t \x01(\tR\adeleted\"\xa1\x01\n" +
+	"\x19GetReleaseHistoryResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12<\n" +
+	"\trevisions\x18\x04 \x03(\v2\x1e.helm.v1alpha1.ReleaseRevisionR\trevisions\"\xc8\x01\n" +
 	"\x18ListChartVersionsRequest\x12\x1b\n" +
 	"\trepo_name\x18\x01 \x01(\tR\brepoName\x12\x1d\n" +
 	"\n" +
 	"chart_name\x18\x02 \x01(\tR\tchartName\x12!\n" +
-	"\fwith_details\x18\x03 \x01(\bR\vwithDetails\"\x89\x01\n" +
+	"\fwith_details\x18\x03 \x01(\bR\vwithDetails\x12\x1e\n" +
+	"\n" +
+	"constraint\x18\x04 \x01(\tR\n" +
+	"constraint\x12-\n" +
+	"\x12include_prerelease\x18\x05 \x01(\bR\x11includePrerelease\"\xc1\x01\n" +
 	"\x10ChartVersionInfo\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
 	"\vapp_version\x18\x02 \x01(\tR\n" +
 	"appVersion\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
-	"\acreated\x18\x04 \x01(\tR\acreated\"s\n" +
+	"\acreated\x18\x04 \x01(\tR\acreated\x12\x16\n" +
+	"\x06digest\x18\x05 \x01(\tR\x06digest\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\x06 \x01(\bR\n" +
+	"deprecated\"s\n" +
 	"\x19ListChartVersionsResponse\x12;\n" +
 	"\bversions\x18\x01 \x03(\v2\x1f.helm.v1alpha1.ChartVersionInfoR\bversions\x12\x19\n" +
-	"\brepo_url\x18\x02 \x01(\tR\arepoUrl\"\xa3\x01\n" +
+	"\brepo_url\x18\x02 \x01(\tR\arepoUrl\"\xb5\x02\n" +
 	"\x1aListInstalledChartsRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x1f\n" +
 	"\vwith_status\x18\x03 \x01(\bR\n" +
 	"withStatus\x12#\n" +
-	"\rwith_manifest\x18\x04 \x01(\bR\fwithManifest\"\x8f\x01\n" +
+	"\rwith_manifest\x18\x04 \x01(\bR\fwithManifest\x12\x16\n" +
+	"\x06status\x18\x05 \x03(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x06 \x01(\tR\tchartName\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04desc\x18\b \x01(\bR\x04desc\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\"\x98\x01\n" +
+	"\x17ListInstalledChartsData\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x129\n" +
+	"\breleases\x18\x04 \x03(\v2\x1d.helm.v1alpha1.InstalledChartR\breleases\"\xa7\x01\n" +
 	"\x1bListInstalledChartsResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x03 \x01(\bR\asuccess\x12(\n" +
-	"\x04data\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\x04data\"\x8f\x03\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12:\n" +
+	"\x04data\x18\x05 \x01(\v2&.helm.v1alpha1.ListInstalledChartsDataR\x04dataJ\x04\b\x04\x10\x05\"\xcd\x03\n" +
 	"\x0eInstalledChart\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1d\n" +
@@ -2589,10 +6777,159 @@ const file_helm_service_proto_rawDesc = "" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
 	"\bmanifest\x18\a \x01(\tR\bmanifest\x124\n" +
 	"\aupdated\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\x12A\n" +
-	"\x06values\x18\t \x03(\v2).helm.v1alpha1.InstalledChart.ValuesEntryR\x06values\x1a9\n" +
+	"\x06values\x18\t \x03(\v2).helm.v1alpha1.InstalledChart.ValuesEntryR\x06values\x12\x1a\n" +
+	"\brevision\x18\n" +
+	" \x01(\x05R\brevision\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x1a9\n" +
 	"\vValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xeb\x0f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x03\n" +
+	"\tOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12!\n" +
+	"\frelease_name\x18\x04 \x01(\tR\vreleaseName\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12,\n" +
+	"\x06result\x18\a \x01(\v2\x14.google.protobuf.AnyR\x06result\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"8\n" +
+	"\x13GetOperationRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x8c\x01\n" +
+	"\x14GetOperationResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12,\n" +
+	"\x04data\x18\x04 \x01(\v2\x18.helm.v1alpha1.OperationR\x04data\"\xae\x01\n" +
+	"\x15ListOperationsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\frelease_name\x18\x02 \x01(\tR\vreleaseName\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\"d\n" +
+	"\x12ListOperationsData\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x128\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\v2\x18.helm.v1alpha1.OperationR\n" +
+	"operations\"\x97\x01\n" +
+	"\x16ListOperationsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x125\n" +
+	"\x04data\x18\x04 \x01(\v2!.helm.v1alpha1.ListOperationsDataR\x04data\";\n" +
+	"\x16CancelOperationRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x8f\x01\n" +
+	"\x17CancelOperationResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12,\n" +
+	"\x04data\x18\x04 \x01(\v2\x18.helm.v1alpha1.OperationR\x04data\"y\n" +
+	"\x12UploadChartRequest\x12@\n" +
+	"\bmetadata\x18\x01 \x01(\v2\".helm.v1alpha1.UploadChartMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xd0\x01\n" +
+	"\x13UploadChartMetadata\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x1b\n" +
+	"\trepo_name\x18\x06 \x01(\tR\brepoName\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\a \x01(\fR\n" +
+	"provenance\"\\\n" +
+	"\x10ChartLintMessage\x12\x1a\n" +
+	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa0\x03\n" +
+	"\x13UploadChartResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1b\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\x12\x1a\n" +
+	"\breceived\x18\x05 \x01(\x03R\breceived\x12\x1c\n" +
+	"\tcompleted\x18\x06 \x01(\bR\tcompleted\x12\x1b\n" +
+	"\tchart_url\x18\a \x01(\tR\bchartUrl\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\b \x01(\tR\tchartName\x12#\n" +
+	"\rchart_version\x18\t \x01(\tR\fchartVersion\x12\x1f\n" +
+	"\vapp_version\x18\n" +
+	" \x01(\tR\n" +
+	"appVersion\x12\x16\n" +
+	"\x06digest\x18\v \x01(\tR\x06digest\x12\x1b\n" +
+	"\tsigned_by\x18\f \x01(\tR\bsignedBy\x123\n" +
+	"\x04lint\x18\r \x03(\v2\x1f.helm.v1alpha1.ChartLintMessageR\x04lint\"\xa6\x01\n" +
+	"\x1bGetChartDependenciesRequest\x12\x1b\n" +
+	"\trepo_name\x18\x01 \x01(\tR\brepoName\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x02 \x01(\tR\tchartName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1f\n" +
+	"\vvalues_yaml\x18\x04 \x01(\tR\n" +
+	"valuesYaml\x12\x10\n" +
+	"\x03set\x18\x05 \x03(\tR\x03set\"\xfc\x02\n" +
+	"\x0eDependencyNode\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12)\n" +
+	"\x10resolved_version\x18\x04 \x01(\tR\x0fresolvedVersion\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x05 \x01(\tR\n" +
+	"repository\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1a\n" +
+	"\bvendored\x18\n" +
+	" \x01(\bR\bvendored\x12\x18\n" +
+	"\amissing\x18\v \x01(\bR\amissing\x12A\n" +
+	"\fdependencies\x18\f \x03(\v2\x1d.helm.v1alpha1.DependencyNodeR\fdependencies\"\x85\x02\n" +
+	"\x1cGetChartDependenciesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x04 \x01(\tR\tchartName\x12#\n" +
+	"\rchart_version\x18\x05 \x01(\tR\fchartVersion\x12\x16\n" +
+	"\x06locked\x18\x06 \x01(\bR\x06locked\x12A\n" +
+	"\fdependencies\x18\a \x03(\v2\x1d.helm.v1alpha1.DependencyNodeR\fdependencies\"J\n" +
+	"\x12CheckAccessRequest\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xea\x01\n" +
+	"\x13CheckAccessResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\aallowed\x18\x04 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x12\n" +
+	"\x04role\x18\a \x01(\tR\x04role\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x12\x14\n" +
+	"\x05roles\x18\t \x03(\tR\x05roles\"\x91\x01\n" +
+	"\x1fEnsureWorkspaceNamespaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x04R\vworkspaceId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x15\n" +
+	"\x06env_id\x18\x03 \x01(\x04R\x05envId\x12\x15\n" +
+	"\x06app_id\x18\x04 \x01(\x04R\x05appId\"\x80\x01\n" +
+	"\x0eWorkspaceScope\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x04R\vworkspaceId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x15\n" +
+	"\x06env_id\x18\x03 \x01(\x04R\x05envId\x12\x15\n" +
+	"\x06app_id\x18\x04 \x01(\x04R\x05appId\"\xa2\x01\n" +
+	" EnsureWorkspaceNamespaceResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\bR\acreated2\x9c \n" +
 	"\x12HelmManagerService\x12p\n" +
 	"\n" +
 	"ListCharts\x12 .helm.v1alpha1.ListChartsRequest\x1a!.helm.v1alpha1.ListChartsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/prod/v1alpha1/charts\x12{\n" +
@@ -2606,8 +6943,23 @@ const file_helm_service_proto_rawDesc = "" +
 	"\x10CheckPodTerminal\x12&.helm.v1alpha1.CheckPodTerminalRequest\x1a'.helm.v1alpha1.CheckPodTerminalResponse\";\x82\xd3\xe4\x93\x025\x123/prod/v1alpha1/pods/{namespace}/{pod_name}/terminal\x12\x9c\x01\n" +
 	"\fUpgradeChart\x12\".helm.v1alpha1.UpgradeChartRequest\x1a#.helm.v1alpha1.UpgradeChartResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/prod/v1alpha1/{namespace}/charts/{release_name}/upgrade\x12\xa0\x01\n" +
 	"\rRollbackChart\x12#.helm.v1alpha1.RollbackChartRequest\x1a$.helm.v1alpha1.RollbackChartResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/prod/v1alpha1/{namespace}/charts/{release_name}/rollback\x12\xa7\x01\n" +
-	"\x11ListChartVersions\x12'.helm.v1alpha1.ListChartVersionsRequest\x1a(.helm.v1alpha1.ListChartVersionsResponse\"?\x82\xd3\xe4\x93\x029\x127/prod/v1alpha1/charts/{repo_name}/{chart_name}/versions\x12\x97\x01\n" +
-	"\x13ListInstalledCharts\x12).helm.v1alpha1.ListInstalledChartsRequest\x1a*.helm.v1alpha1.ListInstalledChartsResponse\")\x82\xd3\xe4\x93\x02#\x12!/prod/v1alpha1/{namespace}/chartsB\x0eZ\f./pkg/pb/;pbb\x06proto3"
+	"\x11ListChartVersions\x12'.helm.v1alpha1.ListChartVersionsRequest\x1a(.helm.v1alpha1.ListChartVersionsResponse\"?\x82\xd3\xe4\x93\x029\x127/prod/v1alpha1/charts/{repo_name}/{chart_name}/versions\x12\xb2\x01\n" +
+	"\x13ListInstalledCharts\x12).helm.v1alpha1.ListInstalledChartsRequest\x1a*.helm.v1alpha1.ListInstalledChartsResponse\"D\x82\xd3\xe4\x93\x02>Z\x19\x12\x17/prod/v1alpha1/releases\x12!/prod/v1alpha1/{namespace}/charts\x12\x89\x01\n" +
+	"\fGetOperation\x12\".helm.v1alpha1.GetOperationRequest\x1a#.helm.v1alpha1.GetOperationResponse\"0\x82\xd3\xe4\x93\x02*\x12(/prod/v1alpha1/operations/{operation_id}\x12\x80\x01\n" +
+	"\x0eListOperations\x12$.helm.v1alpha1.ListOperationsRequest\x1a%.helm.v1alpha1.ListOperationsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/prod/v1alpha1/operations\x12\x9c\x01\n" +
+	"\x0fCancelOperation\x12%.helm.v1alpha1.CancelOperationRequest\x1a&.helm.v1alpha1.CancelOperationResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//prod/v1alpha1/operations/{operation_id}/cancel\x12l\n" +
+	"\tListRepos\x12\x1f.helm.v1alpha1.ListReposRequest\x1a .helm.v1alpha1.ListReposResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/prod/v1alpha1/repos\x12v\n" +
+	"\n" +
+	"RemoveRepo\x12 .helm.v1alpha1.RemoveRepoRequest\x1a!.helm.v1alpha1.RemoveRepoResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/prod/v1alpha1/repos/{name}\x12\x97\x01\n" +
+	"\rListChartTags\x12#.helm.v1alpha1.ListChartTagsRequest\x1a$.helm.v1alpha1.ListChartTagsResponse\";\x82\xd3\xe4\x93\x025\x123/prod/v1alpha1/charts/{repo_name}/{chart_name}/tags\x12\xa0\x01\n" +
+	"\x0fGetChartDetails\x12%.helm.v1alpha1.GetChartDetailsRequest\x1a&.helm.v1alpha1.GetChartDetailsResponse\">\x82\xd3\xe4\x93\x028\x126/prod/v1alpha1/charts/{repo_name}/{chart_name}/details\x12\x96\x01\n" +
+	"\vDiffRelease\x12!.helm.v1alpha1.DiffReleaseRequest\x1a\".helm.v1alpha1.DiffReleaseResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/prod/v1alpha1/{namespace}/charts/{release_name}/diff\x12\xa8\x01\n" +
+	"\x11GetReleaseHistory\x12'.helm.v1alpha1.GetReleaseHistoryRequest\x1a(.helm.v1alpha1.GetReleaseHistoryResponse\"@\x82\xd3\xe4\x93\x02:\x128/prod/v1alpha1/{namespace}/charts/{release_name}/history\x12\xb0\x01\n" +
+	"\x13GetReleaseResources\x12).helm.v1alpha1.GetReleaseResourcesRequest\x1a*.helm.v1alpha1.GetReleaseResourcesResponse\"B\x82\xd3\xe4\x93\x02<\x12:/prod/v1alpha1/{namespace}/charts/{release_name}/resources\x12V\n" +
+	"\vUploadChart\x12!.helm.v1alpha1.UploadChartRequest\x1a\".helm.v1alpha1.UploadChartResponse(\x01\x12\xb7\x01\n" +
+	"\x14GetChartDependencies\x12*.helm.v1alpha1.GetChartDependenciesRequest\x1a+.helm.v1alpha1.GetChartDependenciesResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/prod/v1alpha1/charts/{repo_name}/{chart_name}/dependencies\x12|\n" +
+	"\vCheckAccess\x12!.helm.v1alpha1.CheckAccessRequest\x1a\".helm.v1alpha1.CheckAccessResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/prod/v1alpha1/access/check\x12\xbb\x01\n" +
+	"\x18EnsureWorkspaceNamespace\x12..helm.v1alpha1.EnsureWorkspaceNamespaceRequest\x1a/.helm.v1alpha1.EnsureWorkspaceNamespaceResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/prod/v1alpha1/workspaces/{workspace_id}/namespacesB\x0eZ\f./pkg/pb/;pbb\x06proto3"
 
 var (
 	file_helm_service_proto_rawDescOnce sync.Once
@@ -2621,101 +6973,205 @@ func file_helm_service_proto_rawDescGZIP() []byte {
 	return file_helm_service_proto_rawDescData
 }
 
-var file_helm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_helm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_helm_service_proto_goTypes = []any{
-	(*ListChartsRequest)(nil),              // 0: helm.v1alpha1.ListChartsRequest
-	(*ChartInfo)(nil),                      // 1: helm.v1alpha1.ChartInfo
-	(*ListChartsData)(nil),                 // 2: helm.v1alpha1.ListChartsData
-	(*ListChartsResponse)(nil),             // 3: helm.v1alpha1.ListChartsResponse
-	(*ConfigureRepoRequest)(nil),           // 4: helm.v1alpha1.ConfigureRepoRequest
-	(*ConfigureRepoResponse)(nil),          // 5: helm.v1alpha1.ConfigureRepoResponse
-	(*K8SObject)(nil),                      // 6: helm.v1alpha1.K8sObject
-	(*K8SObjectList)(nil),                  // 7: helm.v1alpha1.K8sObjectList
-	(*InstallChartRequest)(nil),            // 8: helm.v1alpha1.InstallChartRequest
-	(*InstallChartResponse)(nil),           // 9: helm.v1alpha1.InstallChartResponse
-	(*UninstallChartRequest)(nil),          // 10: helm.v1alpha1.UninstallChartRequest
-	(*UninstallChartResponse)(nil),         // 11: helm.v1alpha1.UninstallChartResponse
-	(*WatchInstallStatusRequest)(nil),      // 12: helm.v1alpha1.WatchInstallStatusRequest
-	(*InstallStatus)(nil),                  // 13: helm.v1alpha1.InstallStatus
-	(*ListPodStatusRequest)(nil),           // 14: helm.v1alpha1.ListPodStatusRequest
-	(*PodStatus)(nil),                      // 15: helm.v1alpha1.PodStatus
-	(*ContainerStatus)(nil),                // 16: helm.v1alpha1.ContainerStatus
-	(*PodsStatusList)(nil),                 // 17: helm.v1alpha1.PodsStatusList
-	(*ListPodStatusResponse)(nil),          // 18: helm.v1alpha1.ListPodStatusResponse
-	(*CheckApisixRouteRequest)(nil),        // 19: helm.v1alpha1.CheckApisixRouteRequest
-	(*CheckApisixRouteResponse)(nil),       // 20: helm.v1alpha1.CheckApisixRouteResponse
-	(*CreateChartApplicationRequest)(nil),  // 21: helm.v1alpha1.CreateChartApplicationRequest
-	(*CreateChartApplicationResponse)(nil), // 22: helm.v1alpha1.CreateChartApplicationResponse
-	(*CheckPodTerminalRequest)(nil),        // 23: helm.v1alpha1.CheckPodTerminalRequest
-	(*CheckPodTerminalResponse)(nil),       // 24: helm.v1alpha1.CheckPodTerminalResponse
-	(*ChartSpec)(nil),                      // 25: helm.v1alpha1.ChartSpec
-	(*UpgradeChartRequest)(nil),            // 26: helm.v1alpha1.UpgradeChartRequest
-	(*UpgradeChartResponse)(nil),           // 27: helm.v1alpha1.UpgradeChartResponse
-	(*RollbackChartRequest)(nil),           // 28: helm.v1alpha1.RollbackChartRequest
-	(*RollbackChartResponse)(nil),          // 29: helm.v1alpha1.RollbackChartResponse
-	(*ListChartVersionsRequest)(nil),       // 30: helm.v1alpha1.ListChartVersionsRequest
-	(*ChartVersionInfo)(nil),               // 31: helm.v1alpha1.ChartVersionInfo
-	(*ListChartVersionsResponse)(nil),      // 32: helm.v1alpha1.ListChartVersionsResponse
-	(*ListInstalledChartsRequest)(nil),     // 33: helm.v1alpha1.ListInstalledChartsRequest
-	(*ListInstalledChartsResponse)(nil),    // 34: helm.v1alpha1.ListInstalledChartsResponse
-	(*InstalledChart)(nil),                 // 35: helm.v1alpha1.InstalledChart
-	nil,                                    // 36: helm.v1alpha1.InstallChartResponse.EntriesEntry
-	nil,                                    // 37: helm.v1alpha1.UninstallChartRequest.OptionsEntry
-	nil,                                    // 38: helm.v1alpha1.PodStatus.LabelsEntry
-	nil,                                    // 39: helm.v1alpha1.ChartSpec.ValuesEntry
-	nil,                                    // 40: helm.v1alpha1.InstalledChart.ValuesEntry
-	(*anypb.Any)(nil),                      // 41: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),          // 42: google.protobuf.Timestamp
+	(*ListChartsRequest)(nil),                // 0: helm.v1alpha1.ListChartsRequest
+	(*ChartInfo)(nil),                        // 1: helm.v1alpha1.ChartInfo
+	(*ListChartsData)(nil),                   // 2: helm.v1alpha1.ListChartsData
+	(*ListChartsResponse)(nil),               // 3: helm.v1alpha1.ListChartsResponse
+	(*ConfigureRepoRequest)(nil),             // 4: helm.v1alpha1.ConfigureRepoRequest
+	(*ConfigureRepoResponse)(nil),            // 5: helm.v1alpha1.ConfigureRepoResponse
+	(*RepoInfo)(nil),                         // 6: helm.v1alpha1.RepoInfo
+	(*ListReposRequest)(nil),                 // 7: helm.v1alpha1.ListReposRequest
+	(*ListReposResponse)(nil),                // 8: helm.v1alpha1.ListReposResponse
+	(*RemoveRepoRequest)(nil),                // 9: helm.v1alpha1.RemoveRepoRequest
+	(*RemoveRepoResponse)(nil),               // 10: helm.v1alpha1.RemoveRepoResponse
+	(*ListChartTagsRequest)(nil),             // 11: helm.v1alpha1.ListChartTagsRequest
+	(*ListChartTagsResponse)(nil),            // 12: helm.v1alpha1.ListChartTagsResponse
+	(*GetChartDetailsRequest)(nil),           // 13: helm.v1alpha1.GetChartDetailsRequest
+	(*ChartMaintainer)(nil),                  // 14: helm.v1alpha1.ChartMaintainer
+	(*ChartDependency)(nil),                  // 15: helm.v1alpha1.ChartDependency
+	(*ChartDetails)(nil),                     // 16: helm.v1alpha1.ChartDetails
+	(*GetChartDetailsResponse)(nil),          // 17: helm.v1alpha1.GetChartDetailsResponse
+	(*K8SObject)(nil),                        // 18: helm.v1alpha1.K8sObject
+	(*K8SObjectList)(nil),                    // 19: helm.v1alpha1.K8sObjectList
+	(*InstallChartRequest)(nil),              // 20: helm.v1alpha1.InstallChartRequest
+	(*InstallChartResponse)(nil),             // 21: helm.v1alpha1.InstallChartResponse
+	(*ResourceValidationError)(nil),          // 22: helm.v1alpha1.ResourceValidationError
+	(*UninstallChartRequest)(nil),            // 23: helm.v1alpha1.UninstallChartRequest
+	(*ResourceDeletion)(nil),                 // 24: helm.v1alpha1.ResourceDeletion
+	(*UninstallChartResponse)(nil),           // 25: helm.v1alpha1.UninstallChartResponse
+	(*WatchInstallStatusRequest)(nil),        // 26: helm.v1alpha1.WatchInstallStatusRequest
+	(*InstallStatus)(nil),                    // 27: helm.v1alpha1.InstallStatus
+	(*ListPodStatusRequest)(nil),             // 28: helm.v1alpha1.ListPodStatusRequest
+	(*PodStatus)(nil),                        // 29: helm.v1alpha1.PodStatus
+	(*ContainerStatus)(nil),                  // 30: helm.v1alpha1.ContainerStatus
+	(*PodsStatusList)(nil),                   // 31: helm.v1alpha1.PodsStatusList
+	(*ListPodStatusResponse)(nil),            // 32: helm.v1alpha1.ListPodStatusResponse
+	(*GetReleaseResourcesRequest)(nil),       // 33: helm.v1alpha1.GetReleaseResourcesRequest
+	(*ResourceNode)(nil),                     // 34: helm.v1alpha1.ResourceNode
+	(*ReleaseResources)(nil),                 // 35: helm.v1alpha1.ReleaseResources
+	(*GetReleaseResourcesResponse)(nil),      // 36: helm.v1alpha1.GetReleaseResourcesResponse
+	(*CheckApisixRouteRequest)(nil),          // 37: helm.v1alpha1.CheckApisixRouteRequest
+	(*CheckApisixRouteResponse)(nil),         // 38: helm.v1alpha1.CheckApisixRouteResponse
+	(*CreateChartApplicationRequest)(nil),    // 39: helm.v1alpha1.CreateChartApplicationRequest
+	(*CreateChartApplicationResponse)(nil),   // 40: helm.v1alpha1.CreateChartApplicationResponse
+	(*CheckPodTerminalRequest)(nil),          // 41: helm.v1alpha1.CheckPodTerminalRequest
+	(*CheckPodTerminalResponse)(nil),         // 42: helm.v1alpha1.CheckPodTerminalResponse
+	(*ChartSpec)(nil),                        // 43: helm.v1alpha1.ChartSpec
+	(*UpgradeChartRequest)(nil),              // 44: helm.v1alpha1.UpgradeChartRequest
+	(*UpgradeChartResponse)(nil),             // 45: helm.v1alpha1.UpgradeChartResponse
+	(*DiffReleaseRequest)(nil),               // 46: helm.v1alpha1.DiffReleaseRequest
+	(*ResourceDiff)(nil),                     // 47: helm.v1alpha1.ResourceDiff
+	(*DiffReleaseResponse)(nil),              // 48: helm.v1alpha1.DiffReleaseResponse
+	(*RollbackChartRequest)(nil),             // 49: helm.v1alpha1.RollbackChartRequest
+	(*RollbackChartResponse)(nil),            // 50: helm.v1alpha1.RollbackChartResponse
+	(*GetReleaseHistoryRequest)(nil),         // 51: helm.v1alpha1.GetReleaseHistoryRequest
+	(*ReleaseRevision)(nil),                  // 52: helm.v1alpha1.ReleaseRevision
+	(*GetReleaseHistoryResponse)(nil),        // 53: helm.v1alpha1.GetReleaseHistoryResponse
+	(*ListChartVersionsRequest)(nil),         // 54: helm.v1alpha1.ListChartVersionsRequest
+	(*ChartVersionInfo)(nil),                 // 55: helm.v1alpha1.ChartVersionInfo
+	(*ListChartVersionsResponse)(nil),        // 56: helm.v1alpha1.ListChartVersionsResponse
+	(*ListInstalledChartsRequest)(nil),       // 57: helm.v1alpha1.ListInstalledChartsRequest
+	(*ListInstalledChartsData)(nil),          // 58: helm.v1alpha1.ListInstalledChartsData
+	(*ListInstalledChartsResponse)(nil),      // 59: helm.v1alpha1.ListInstalledChartsResponse
+	(*InstalledChart)(nil),                   // 60: helm.v1alpha1.InstalledChart
+	(*Operation)(nil),                        // 61: helm.v1alpha1.Operation
+	(*GetOperationRequest)(nil),              // 62: helm.v1alpha1.GetOperationRequest
+	(*GetOperationResponse)(nil),             // 63: helm.v1alpha1.GetOperationResponse
+	(*ListOperationsRequest)(nil),            // 64: helm.v1alpha1.ListOperationsRequest
+	(*ListOperationsData)(nil),               // 65: helm.v1alpha1.ListOperationsData
+	(*ListOperationsResponse)(nil),           // 66: helm.v1alpha1.ListOperationsResponse
+	(*CancelOperationRequest)(nil),           // 67: helm.v1alpha1.CancelOperationRequest
+	(*CancelOperationResponse)(nil),          // 68: helm.v1alpha1.CancelOperationResponse
+	(*UploadChartRequest)(nil),               // 69: helm.v1alpha1.UploadChartRequest
+	(*UploadChartMetadata)(nil),              // 70: helm.v1alpha1.UploadChartMetadata
+	(*ChartLintMessage)(nil),                 // 71: helm.v1alpha1.ChartLintMessage
+	(*UploadChartResponse)(nil),              // 72: helm.v1alpha1.UploadChartResponse
+	(*GetChartDependenciesRequest)(nil),      // 73: helm.v1alpha1.GetChartDependenciesRequest
+	(*DependencyNode)(nil),                   // 74: helm.v1alpha1.DependencyNode
+	(*GetChartDependenciesResponse)(nil),     // 75: helm.v1alpha1.GetChartDependenciesResponse
+	(*CheckAccessRequest)(nil),               // 76: helm.v1alpha1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),              // 77: helm.v1alpha1.CheckAccessResponse
+	(*EnsureWorkspaceNamespaceRequest)(nil),  // 78: helm.v1alpha1.EnsureWorkspaceNamespaceRequest
+	(*WorkspaceScope)(nil),                   // 79: helm.v1alpha1.WorkspaceScope
+	(*EnsureWorkspaceNamespaceResponse)(nil), // 80: helm.v1alpha1.EnsureWorkspaceNamespaceResponse
+	nil,                                      // 81: helm.v1alpha1.ChartDetails.AnnotationsEntry
+	nil,                                      // 82: helm.v1alpha1.InstallChartResponse.EntriesEntry
+	nil,                                      // 83: helm.v1alpha1.UninstallChartRequest.OptionsEntry
+	nil,                                      // 84: helm.v1alpha1.PodStatus.LabelsEntry
+	nil,                                      // 85: helm.v1alpha1.ChartSpec.ValuesEntry
+	nil,                                      // 86: helm.v1alpha1.InstalledChart.ValuesEntry
+	(*anypb.Any)(nil),                        // 87: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),            // 88: google.protobuf.Timestamp
 }
 var file_helm_service_proto_depIdxs = []int32{
 	1,  // 0: helm.v1alpha1.ListChartsData.charts:type_name -> helm.v1alpha1.ChartInfo
-	41, // 1: helm.v1alpha1.ListChartsResponse.data:type_name -> google.protobuf.Any
-	41, // 2: helm.v1alpha1.K8sObject.object:type_name -> google.protobuf.Any
-	6,  // 3: helm.v1alpha1.K8sObjectList.items:type_name -> helm.v1alpha1.K8sObject
-	36, // 4: helm.v1alpha1.InstallChartResponse.entries:type_name -> helm.v1alpha1.InstallChartResponse.EntriesEntry
-	37, // 5: helm.v1alpha1.UninstallChartRequest.options:type_name -> helm.v1alpha1.UninstallChartRequest.OptionsEntry
-	38, // 6: helm.v1alpha1.PodStatus.labels:type_name -> helm.v1alpha1.PodStatus.LabelsEntry
-	16, // 7: helm.v1alpha1.PodStatus.containers:type_name -> helm.v1alpha1.ContainerStatus
-	15, // 8: helm.v1alpha1.PodsStatusList.pods:type_name -> helm.v1alpha1.PodStatus
-	17, // 9: helm.v1alpha1.ListPodStatusResponse.data:type_name -> helm.v1alpha1.PodsStatusList
-	39, // 10: helm.v1alpha1.ChartSpec.values:type_name -> helm.v1alpha1.ChartSpec.ValuesEntry
-	25, // 11: helm.v1alpha1.UpgradeChartRequest.chart:type_name -> helm.v1alpha1.ChartSpec
-	31, // 12: helm.v1alpha1.ListChartVersionsResponse.versions:type_name -> helm.v1alpha1.ChartVersionInfo
-	41, // 13: helm.v1alpha1.ListInstalledChartsResponse.data:type_name -> google.protobuf.Any
-	42, // 14: helm.v1alpha1.InstalledChart.updated:type_name -> google.protobuf.Timestamp
-	40, // 15: helm.v1alpha1.InstalledChart.values:type_name -> helm.v1alpha1.InstalledChart.ValuesEntry
-	7,  // 16: helm.v1alpha1.InstallChartResponse.EntriesEntry.value:type_name -> helm.v1alpha1.K8sObjectList
-	0,  // 17: helm.v1alpha1.HelmManagerService.ListCharts:input_type -> helm.v1alpha1.ListChartsRequest
-	4,  // 18: helm.v1alpha1.HelmManagerService.ConfigureRepo:input_type -> helm.v1alpha1.ConfigureRepoRequest
-	8,  // 19: helm.v1alpha1.HelmManagerService.InstallChart:input_type -> helm.v1alpha1.InstallChartRequest
-	10, // 20: helm.v1alpha1.HelmManagerService.UninstallChart:input_type -> helm.v1alpha1.UninstallChartRequest
-	12, // 21: helm.v1alpha1.HelmManagerService.WatchInstallStatus:input_type -> helm.v1alpha1.WatchInstallStatusRequest
-	14, // 22: helm.v1alpha1.HelmManagerService.ListPodStatus:input_type -> helm.v1alpha1.ListPodStatusRequest
-	19, // 23: helm.v1alpha1.HelmManagerService.CheckApisixRoute:input_type -> helm.v1alpha1.CheckApisixRouteRequest
-	21, // 24: helm.v1alpha1.HelmManagerService.CreateChartApplication:input_type -> helm.v1alpha1.CreateChartApplicationRequest
-	23, // 25: helm.v1alpha1.HelmManagerService.CheckPodTerminal:input_type -> helm.v1alpha1.CheckPodTerminalRequest
-	26, // 26: helm.v1alpha1.HelmManagerService.UpgradeChart:input_type -> helm.v1alpha1.UpgradeChartRequest
-	28, // 27: helm.v1alpha1.HelmManagerService.RollbackChart:input_type -> helm.v1alpha1.RollbackChartRequest
-	30, // 28: helm.v1alpha1.HelmManagerService.ListChartVersions:input_type -> helm.v1alpha1.ListChartVersionsRequest
-	33, // 29: helm.v1alpha1.HelmManagerService.ListInstalledCharts:input_type -> helm.v1alpha1.ListInstalledChartsRequest
-	3,  // 30: helm.v1alpha1.HelmManagerService.ListCharts:output_type -> helm.v1alpha1.ListChartsResponse
-	5,  // 31: helm.v1alpha1.HelmManagerService.ConfigureRepo:output_type -> helm.v1alpha1.ConfigureRepoResponse
-	9,  // 32: helm.v1alpha1.HelmManagerService.InstallChart:output_type -> helm.v1alpha1.InstallChartResponse
-	11, // 33: helm.v1alpha1.HelmManagerService.UninstallChart:output_type -> helm.v1alpha1.UninstallChartResponse
-	13, // 34: helm.v1alpha1.HelmManagerService.WatchInstallStatus:output_type -> helm.v1alpha1.InstallStatus
-	18, // 35: helm.v1alpha1.HelmManagerService.ListPodStatus:output_type -> helm.v1alpha1.ListPodStatusResponse
-	20, // 36: helm.v1alpha1.HelmManagerService.CheckApisixRoute:output_type -> helm.v1alpha1.CheckApisixRouteResponse
-	22, // 37: helm.v1alpha1.HelmManagerService.CreateChartApplication:output_type -> helm.v1alpha1.CreateChartApplicationResponse
-	24, // 38: helm.v1alpha1.HelmManagerService.CheckPodTerminal:output_type -> helm.v1alpha1.CheckPodTerminalResponse
-	27, // 39: helm.v1alpha1.HelmManagerService.UpgradeChart:output_type -> helm.v1alpha1.UpgradeChartResponse
-	29, // 40: helm.v1alpha1.HelmManagerService.RollbackChart:output_type -> helm.v1alpha1.RollbackChartResponse
-	32, // 41: helm.v1alpha1.HelmManagerService.ListChartVersions:output_type -> helm.v1alpha1.ListChartVersionsResponse
-	34, // 42: helm.v1alpha1.HelmManagerService.ListInstalledCharts:output_type -> helm.v1alpha1.ListInstalledChartsResponse
-	30, // [30:43] is the sub-list for method output_type
-	17, // [17:30] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	87, // 1: helm.v1alpha1.ListChartsResponse.data:type_name -> google.protobuf.Any
+	6,  // 2: helm.v1alpha1.ConfigureRepoResponse.data:type_name -> helm.v1alpha1.RepoInfo
+	6,  // 3: helm.v1alpha1.ListReposResponse.data:type_name -> helm.v1alpha1.RepoInfo
+	14, // 4: helm.v1alpha1.ChartDetails.maintainers:type_name -> helm.v1alpha1.ChartMaintainer
+	81, // 5: helm.v1alpha1.ChartDetails.annotations:type_name -> helm.v1alpha1.ChartDetails.AnnotationsEntry
+	15, // 6: helm.v1alpha1.ChartDetails.dependencies:type_name -> helm.v1alpha1.ChartDependency
+	16, // 7: helm.v1alpha1.GetChartDetailsResponse.data:type_name -> helm.v1alpha1.ChartDetails
+	87, // 8: helm.v1alpha1.K8sObject.object:type_name -> google.protobuf.Any
+	18, // 9: helm.v1alpha1.K8sObjectList.items:type_name -> helm.v1alpha1.K8sObject
+	79, // 10: helm.v1alpha1.InstallChartRequest.scope:type_name -> helm.v1alpha1.WorkspaceScope
+	82, // 11: helm.v1alpha1.InstallChartResponse.entries:type_name -> helm.v1alpha1.InstallChartResponse.EntriesEntry
+	22, // 12: helm.v1alpha1.InstallChartResponse.validation_errors:type_name -> helm.v1alpha1.ResourceValidationError
+	83, // 13: helm.v1alpha1.UninstallChartRequest.options:type_name -> helm.v1alpha1.UninstallChartRequest.OptionsEntry
+	24, // 14: helm.v1alpha1.UninstallChartResponse.deletions:type_name -> helm.v1alpha1.ResourceDeletion
+	84, // 15: helm.v1alpha1.PodStatus.labels:type_name -> helm.v1alpha1.PodStatus.LabelsEntry
+	30, // 16: helm.v1alpha1.PodStatus.containers:type_name -> helm.v1alpha1.ContainerStatus
+	29, // 17: helm.v1alpha1.PodsStatusList.pods:type_name -> helm.v1alpha1.PodStatus
+	31, // 18: helm.v1alpha1.ListPodStatusResponse.data:type_name -> helm.v1alpha1.PodsStatusList
+	29, // 19: helm.v1alpha1.ResourceNode.pod:type_name -> helm.v1alpha1.PodStatus
+	34, // 20: helm.v1alpha1.ResourceNode.children:type_name -> helm.v1alpha1.ResourceNode
+	34, // 21: helm.v1alpha1.ReleaseResources.resources:type_name -> helm.v1alpha1.ResourceNode
+	35, // 22: helm.v1alpha1.GetReleaseResourcesResponse.data:type_name -> helm.v1alpha1.ReleaseResources
+	79, // 23: helm.v1alpha1.CreateChartApplicationRequest.scope:type_name -> helm.v1alpha1.WorkspaceScope
+	85, // 24: helm.v1alpha1.ChartSpec.values:type_name -> helm.v1alpha1.ChartSpec.ValuesEntry
+	43, // 25: helm.v1alpha1.UpgradeChartRequest.chart:type_name -> helm.v1alpha1.ChartSpec
+	43, // 26: helm.v1alpha1.DiffReleaseRequest.chart:type_name -> helm.v1alpha1.ChartSpec
+	47, // 27: helm.v1alpha1.DiffReleaseResponse.resources:type_name -> helm.v1alpha1.ResourceDiff
+	52, // 28: helm.v1alpha1.GetReleaseHistoryResponse.revisions:type_name -> helm.v1alpha1.ReleaseRevision
+	55, // 29: helm.v1alpha1.ListChartVersionsResponse.versions:type_name -> helm.v1alpha1.ChartVersionInfo
+	60, // 30: helm.v1alpha1.ListInstalledChartsData.releases:type_name -> helm.v1alpha1.InstalledChart
+	58, // 31: helm.v1alpha1.ListInstalledChartsResponse.data:type_name -> helm.v1alpha1.ListInstalledChartsData
+	88, // 32: helm.v1alpha1.InstalledChart.updated:type_name -> google.protobuf.Timestamp
+	86, // 33: helm.v1alpha1.InstalledChart.values:type_name -> helm.v1alpha1.InstalledChart.ValuesEntry
+	87, // 34: helm.v1alpha1.Operation.result:type_name -> google.protobuf.Any
+	88, // 35: helm.v1alpha1.Operation.created_at:type_name -> google.protobuf.Timestamp
+	88, // 36: helm.v1alpha1.Operation.started_at:type_name -> google.protobuf.Timestamp
+	88, // 37: helm.v1alpha1.Operation.finished_at:type_name -> google.protobuf.Timestamp
+	61, // 38: helm.v1alpha1.GetOperationResponse.data:type_name -> helm.v1alpha1.Operation
+	61, // 39: helm.v1alpha1.ListOperationsData.operations:type_name -> helm.v1alpha1.Operation
+	65, // 40: helm.v1alpha1.ListOperationsResponse.data:type_name -> helm.v1alpha1.ListOperationsData
+	61, // 41: helm.v1alpha1.CancelOperationResponse.data:type_name -> helm.v1alpha1.Operation
+	70, // 42: helm.v1alpha1.UploadChartRequest.metadata:type_name -> helm.v1alpha1.UploadChartMetadata
+	71, // 43: helm.v1alpha1.UploadChartResponse.lint:type_name -> helm.v1alpha1.ChartLintMessage
+	74, // 44: helm.v1alpha1.DependencyNode.dependencies:type_name -> helm.v1alpha1.DependencyNode
+	74, // 45: helm.v1alpha1.GetChartDependenciesResponse.dependencies:type_name -> helm.v1alpha1.DependencyNode
+	19, // 46: helm.v1alpha1.InstallChartResponse.EntriesEntry.value:type_name -> helm.v1alpha1.K8sObjectList
+	0,  // 47: helm.v1alpha1.HelmManagerService.ListCharts:input_type -> helm.v1alpha1.ListChartsRequest
+	4,  // 48: helm.v1alpha1.HelmManagerService.ConfigureRepo:input_type -> helm.v1alpha1.ConfigureRepoRequest
+	20, // 49: helm.v1alpha1.HelmManagerService.InstallChart:input_type -> helm.v1alpha1.InstallChartRequest
+	23, // 50: helm.v1alpha1.HelmManagerService.UninstallChart:input_type -> helm.v1alpha1.UninstallChartRequest
+	26, // 51: helm.v1alpha1.HelmManagerService.WatchInstallStatus:input_type -> helm.v1alpha1.WatchInstallStatusRequest
+	28, // 52: helm.v1alpha1.HelmManagerService.ListPodStatus:input_type -> helm.v1alpha1.ListPodStatusRequest
+	37, // 53: helm.v1alpha1.HelmManagerService.CheckApisixRoute:input_type -> helm.v1alpha1.CheckApisixRouteRequest
+	39, // 54: helm.v1alpha1.HelmManagerService.CreateChartApplication:input_type -> helm.v1alpha1.CreateChartApplicationRequest
+	41, // 55: helm.v1alpha1.HelmManagerService.CheckPodTerminal:input_type -> helm.v1alpha1.CheckPodTerminalRequest
+	44, // 56: helm.v1alpha1.HelmManagerService.UpgradeChart:input_type -> helm.v1alpha1.UpgradeChartRequest
+	49, // 57: helm.v1alpha1.HelmManagerService.RollbackChart:input_type -> helm.v1alpha1.RollbackChartRequest
+	54, // 58: helm.v1alpha1.HelmManagerService.ListChartVersions:input_type -> helm.v1alpha1.ListChartVersionsRequest
+	57, // 59: helm.v1alpha1.HelmManagerService.ListInstalledCharts:input_type -> helm.v1alpha1.ListInstalledChartsRequest
+	62, // 60: helm.v1alpha1.HelmManagerService.GetOperation:input_type -> helm.v1alpha1.GetOperationRequest
+	64, // 61: helm.v1alpha1.HelmManagerService.ListOperations:input_type -> helm.v1alpha1.ListOperationsRequest
+	67, // 62: helm.v1alpha1.HelmManagerService.CancelOperation:input_type -> helm.v1alpha1.CancelOperationRequest
+	7,  // 63: helm.v1alpha1.HelmManagerService.ListRepos:input_type -> helm.v1alpha1.ListReposRequest
+	9,  // 64: helm.v1alpha1.HelmManagerService.RemoveRepo:input_type -> helm.v1alpha1.RemoveRepoRequest
+	11, // 65: helm.v1alpha1.HelmManagerService.ListChartTags:input_type -> helm.v1alpha1.ListChartTagsRequest
+	13, // 66: helm.v1alpha1.HelmManagerService.GetChartDetails:input_type -> helm.v1alpha1.GetChartDetailsRequest
+	46, // 67: helm.v1alpha1.HelmManagerService.DiffRelease:input_type -> helm.v1alpha1.DiffReleaseRequest
+	51, // 68: helm.v1alpha1.HelmManagerService.GetReleaseHistory:input_type -> helm.v1alpha1.GetReleaseHistoryRequest
+	33, // 69: helm.v1alpha1.HelmManagerService.GetReleaseResources:input_type -> helm.v1alpha1.GetReleaseResourcesRequest
+	69, // 70: helm.v1alpha1.HelmManagerService.UploadChart:input_type -> helm.v1alpha1.UploadChartRequest
+	73, // 71: helm.v1alpha1.HelmManagerService.GetChartDependencies:input_type -> helm.v1alpha1.GetChartDependenciesRequest
+	76, // 72: helm.v1alpha1.HelmManagerService.CheckAccess:input_type -> helm.v1alpha1.CheckAccessRequest
+	78, // 73: helm.v1alpha1.HelmManagerService.EnsureWorkspaceNamespace:input_type -> helm.v1alpha1.EnsureWorkspaceNamespaceRequest
+	3,  // 74: helm.v1alpha1.HelmManagerService.ListCharts:output_type -> helm.v1alpha1.ListChartsResponse
+	5,  // 75: helm.v1alpha1.HelmManagerService.ConfigureRepo:output_type -> helm.v1alpha1.ConfigureRepoResponse
+	21, // 76: helm.v1alpha1.HelmManagerService.InstallChart:output_type -> helm.v1alpha1.InstallChartResponse
+	25, // 77: helm.v1alpha1.HelmManagerService.UninstallChart:output_type -> helm.v1alpha1.UninstallChartResponse
+	27, // 78: helm.v1alpha1.HelmManagerService.WatchInstallStatus:output_type -> helm.v1alpha1.InstallStatus
+	32, // 79: helm.v1alpha1.HelmManagerService.ListPodStatus:output_type -> helm.v1alpha1.ListPodStatusResponse
+	38, // 80: helm.v1alpha1.HelmManagerService.CheckApisixRoute:output_type -> helm.v1alpha1.CheckApisixRouteResponse
+	40, // 81: helm.v1alpha1.HelmManagerService.CreateChartApplication:output_type -> helm.v1alpha1.CreateChartApplicationResponse
+	42, // 82: helm.v1alpha1.HelmManagerService.CheckPodTerminal:output_type -> helm.v1alpha1.CheckPodTerminalResponse
+	45, // 83: helm.v1alpha1.HelmManagerService.UpgradeChart:output_type -> helm.v1alpha1.UpgradeChartResponse
+	50, // 84: helm.v1alpha1.HelmManagerService.RollbackChart:output_type -> helm.v1alpha1.RollbackChartResponse
+	56, // 85: helm.v1alpha1.HelmManagerService.ListChartVersions:output_type -> helm.v1alpha1.ListChartVersionsResponse
+	59, // 86: helm.v1alpha1.HelmManagerService.ListInstalledCharts:output_type -> helm.v1alpha1.ListInstalledChartsResponse
+	63, // 87: helm.v1alpha1.HelmManagerService.GetOperation:output_type -> helm.v1alpha1.GetOperationResponse
+	66, // 88: helm.v1alpha1.HelmManagerService.ListOperations:output_type -> helm.v1alpha1.ListOperationsResponse
+	68, // 89: helm.v1alpha1.HelmManagerService.CancelOperation:output_type -> helm.v1alpha1.CancelOperationResponse
+	8,  // 90: helm.v1alpha1.HelmManagerService.ListRepos:output_type -> helm.v1alpha1.ListReposResponse
+	10, // 91: helm.v1alpha1.HelmManagerService.RemoveRepo:output_type -> helm.v1alpha1.RemoveRepoResponse
+	12, // 92: helm.v1alpha1.HelmManagerService.ListChartTags:output_type -> helm.v1alpha1.ListChartTagsResponse
+	17, // 93: helm.v1alpha1.HelmManagerService.GetChartDetails:output_type -> helm.v1alpha1.GetChartDetailsResponse
+	48, // 94: helm.v1alpha1.HelmManagerService.DiffRelease:output_type -> helm.v1alpha1.DiffReleaseResponse
+	53, // 95: helm.v1alpha1.HelmManagerService.GetReleaseHistory:output_type -> helm.v1alpha1.GetReleaseHistoryResponse
+	36, // 96: helm.v1alpha1.HelmManagerService.GetReleaseResources:output_type -> helm.v1alpha1.GetReleaseResourcesResponse
+	72, // 97: helm.v1alpha1.HelmManagerService.UploadChart:output_type -> helm.v1alpha1.UploadChartResponse
+	75, // 98: helm.v1alpha1.HelmManagerService.GetChartDependencies:output_type -> helm.v1alpha1.GetChartDependenciesResponse
+	77, // 99: helm.v1alpha1.HelmManagerService.CheckAccess:output_type -> helm.v1alpha1.CheckAccessResponse
+	80, // 100: helm.v1alpha1.HelmManagerService.EnsureWorkspaceNamespace:output_type -> helm.v1alpha1.EnsureWorkspaceNamespaceResponse
+	74, // [74:101] is the sub-list for method output_type
+	47, // [47:74] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_helm_service_proto_init() }
@@ -2723,13 +7179,17 @@ func file_helm_service_proto_init() {
 	if File_helm_service_proto != nil {
 		return
 	}
+	file_helm_service_proto_msgTypes[69].OneofWrappers = []any{
+		(*UploadChartRequest_Metadata)(nil),
+		(*UploadChartRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_helm_service_proto_rawDesc), len(file_helm_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   41,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HelmManagerService_ListCharts_FullMethodName             = "/helm.v1alpha1.HelmManagerService/ListCharts"
-	HelmManagerService_ConfigureRepo_FullMethodName          = "/helm.v1alpha1.HelmManagerService/ConfigureRepo"
-	HelmManagerService_InstallChart_FullMethodName           = "/helm.v1alpha1.HelmManagerService/InstallChart"
-	HelmManagerService_UninstallChart_FullMethodName         = "/helm.v1alpha1.HelmManagerService/UninstallChart"
-	HelmManagerService_WatchInstallStatus_FullMethodName     = "/helm.v1alpha1.HelmManagerService/WatchInstallStatus"
-	HelmManagerService_ListPodStatus_FullMethodName          = "/helm.v1alpha1.HelmManagerService/ListPodStatus"
-	HelmManagerService_CheckApisixRoute_FullMethodName       = "/helm.v1alpha1.HelmManagerService/CheckApisixRoute"
-	HelmManagerService_CreateChartApplication_FullMethodName = "/helm.v1alpha1.HelmManagerService/CreateChartApplication"
-	HelmManagerService_CheckPodTerminal_FullMethodName       = "/helm.v1alpha1.HelmManagerService/CheckPodTerminal"
-	HelmManagerService_UpgradeChart_FullMethodName           = "/helm.v1alpha1.HelmManagerService/UpgradeChart"
-	HelmManagerService_RollbackChart_FullMethodName          = "/helm.v1alpha1.HelmManagerService/RollbackChart"
-	HelmManagerService_ListChartVersions_FullMethodName      = "/helm.v1alpha1.HelmManagerService/ListChartVersions"
-	HelmManagerService_ListInstalledCharts_FullMethodName    = "/helm.v1alpha1.HelmManagerService/ListInstalledCharts"
+	HelmManagerService_ListCharts_FullMethodName               = "/helm.v1alpha1.HelmManagerService/ListCharts"
+	HelmManagerService_ConfigureRepo_FullMethodName            = "/helm.v1alpha1.HelmManagerService/ConfigureRepo"
+	HelmManagerService_InstallChart_FullMethodName             = "/helm.v1alpha1.HelmManagerService/InstallChart"
+	HelmManagerService_UninstallChart_FullMethodName           = "/helm.v1alpha1.HelmManagerService/UninstallChart"
+	HelmManagerService_WatchInstallStatus_FullMethodName       = "/helm.v1alpha1.HelmManagerService/WatchInstallStatus"
+	HelmManagerService_ListPodStatus_FullMethodName            = "/helm.v1alpha1.HelmManagerService/ListPodStatus"
+	HelmManagerService_CheckApisixRoute_FullMethodName         = "/helm.v1alpha1.HelmManagerService/CheckApisixRoute"
+	HelmManagerService_CreateChartApplication_FullMethodName   = "/helm.v1alpha1.HelmManagerService/CreateChartApplication"
+	HelmManagerService_CheckPodTerminal_FullMethodName         = "/helm.v1alpha1.HelmManagerService/CheckPodTerminal"
+	HelmManagerService_UpgradeChart_FullMethodName             = "/helm.v1alpha1.HelmManagerService/UpgradeChart"
+	HelmManagerService_RollbackChart_FullMethodName            = "/helm.v1alpha1.HelmManagerService/RollbackChart"
+	HelmManagerService_ListChartVersions_FullMethodName        = "/helm.v1alpha1.HelmManagerService/ListChartVersions"
+	HelmManagerService_ListInstalledCharts_FullMethodName      = "/helm.v1alpha1.HelmManagerService/ListInstalledCharts"
+	HelmManagerService_GetOperation_FullMethodName             = "/helm.v1alpha1.HelmManagerService/GetOperation"
+	HelmManagerService_ListOperations_FullMethodName           = "/helm.v1alpha1.HelmManagerService/ListOperations"
+	HelmManagerService_CancelOperation_FullMethodName          = "/helm.v1alpha1.HelmManagerService/CancelOperation"
+	HelmManagerService_ListRepos_FullMethodName                = "/helm.v1alpha1.HelmManagerService/ListRepos"
+	HelmManagerService_RemoveRepo_FullMethodName               = "/helm.v1alpha1.HelmManagerService/RemoveRepo"
+	HelmManagerService_ListChartTags_FullMethodName            = "/helm.v1alpha1.HelmManagerService/ListChartTags"
+	HelmManagerService_GetChartDetails_FullMethodName          = "/helm.v1alpha1.HelmManagerService/GetChartDetails"
+	HelmManagerService_DiffRelease_FullMethodName              = "/helm.v1alpha1.HelmManagerService/DiffRelease"
+	HelmManagerService_GetReleaseHistory_FullMethodName        = "/helm.v1alpha1.HelmManagerService/GetReleaseHistory"
+	HelmManagerService_GetReleaseResources_FullMethodName      = "/helm.v1alpha1.HelmManagerService/GetReleaseResources"
+	HelmManagerService_UploadChart_FullMethodName              = "/helm.v1alpha1.HelmManagerService/UploadChart"
+	HelmManagerService_GetChartDependencies_FullMethodName     = "/helm.v1alpha1.HelmManagerService/GetChartDependencies"
+	HelmManagerService_CheckAccess_FullMethodName              = "/helm.v1alpha1.HelmManagerService/CheckAccess"
+	HelmManagerService_EnsureWorkspaceNamespace_FullMethodName = "/helm.v1alpha1.HelmManagerService/EnsureWorkspaceNamespace"
 )
 
 // HelmManagerServiceClient is the client API for HelmManagerService service.
@@ -64,6 +78,36 @@ type HelmManagerServiceClient interface {
 	ListChartVersions(ctx context.Context, in *ListChartVersionsRequest, opts ...grpc.CallOption) (*ListChartVersionsResponse, error)
 	// 15. 获取应用列表
 	ListInstalledCharts(ctx context.Context, in *ListInstalledChartsRequest, opts ...grpc.CallOption) (*ListInstalledChartsResponse, error)
+	// 16. 查询异步操作（安装/升级/卸载/回滚）
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// 17. 异步操作列表
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// 18. 取消异步操作
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
+	// 19. 获取已配置的仓库列表
+	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
+	// 20. 删除仓库
+	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoResponse, error)
+	// 21. 查询 OCI 仓库中 Chart 的 tag 列表
+	ListChartTags(ctx context.Context, in *ListChartTagsRequest, opts ...grpc.CallOption) (*ListChartTagsResponse, error)
+	// 22. 获取 Chart 详情（默认 values、values schema、README、依赖）
+	GetChartDetails(ctx context.Context, in *GetChartDetailsRequest, opts ...grpc.CallOption) (*GetChartDetailsResponse, error)
+	// 23. 预览升级：对比当前 release 与升级后的资源差异
+	DiffRelease(ctx context.Context, in *DiffReleaseRequest, opts ...grpc.CallOption) (*DiffReleaseResponse, error)
+	// 24. 查询 release 历史版本
+	GetReleaseHistory(ctx context.Context, in *GetReleaseHistoryRequest, opts ...grpc.CallOption) (*GetReleaseHistoryResponse, error)
+	// 25. 查询 release 的资源及健康状态
+	GetReleaseResources(ctx context.Context, in *GetReleaseResourcesRequest, opts ...grpc.CallOption) (*GetReleaseResourcesResponse, error)
+	// 26. 流式上传 chart 包：第一条消息为元数据，之后为文件分片，支持按 upload_id 断点续传。
+	// 服务端收到元数据后立即通过响应头 upload-id、upload-offset 返回上传ID和已接收的字节数，
+	// 连接中断时客户端据此续传
+	UploadChart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChartRequest, UploadChartResponse], error)
+	// 27. 解析 chart 的依赖树，并按给定的 values 计算 condition/tags
+	GetChartDependencies(ctx context.Context, in *GetChartDependenciesRequest, opts ...grpc.CallOption) (*GetChartDependenciesResponse, error)
+	// 28. 检查当前调用方能否在指定命名空间调用某个接口，只做判定不执行操作
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+	// 29. 获取工作空间/项目/环境对应的托管命名空间，首次使用时创建
+	EnsureWorkspaceNamespace(ctx context.Context, in *EnsureWorkspaceNamespaceRequest, opts ...grpc.CallOption) (*EnsureWorkspaceNamespaceResponse, error)
 }
 
 type helmManagerServiceClient struct {
@@ -213,6 +257,149 @@ func (c *helmManagerServiceClient) ListInstalledCharts(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *helmManagerServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_ListOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOperationResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_ListRepos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRepoResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_RemoveRepo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) ListChartTags(ctx context.Context, in *ListChartTagsRequest, opts ...grpc.CallOption) (*ListChartTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChartTagsResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_ListChartTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) GetChartDetails(ctx context.Context, in *GetChartDetailsRequest, opts ...grpc.CallOption) (*GetChartDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChartDetailsResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_GetChartDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) DiffRelease(ctx context.Context, in *DiffReleaseRequest, opts ...grpc.CallOption) (*DiffReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffReleaseResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_DiffRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) GetReleaseHistory(ctx context.Context, in *GetReleaseHistoryRequest, opts ...grpc.CallOption) (*GetReleaseHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReleaseHistoryResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_GetReleaseHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) GetReleaseResources(ctx context.Context, in *GetReleaseResourcesRequest, opts ...grpc.CallOption) (*GetReleaseResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReleaseResourcesResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_GetReleaseResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) UploadChart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChartRequest, UploadChartResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HelmManagerService_ServiceDesc.Streams[1], HelmManagerService_UploadChart_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChartRequest, UploadChartResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HelmManagerService_UploadChartClient = grpc.ClientStreamingClient[UploadChartRequest, UploadChartResponse]
+
+func (c *helmManagerServiceClient) GetChartDependencies(ctx context.Context, in *GetChartDependenciesRequest, opts ...grpc.CallOption) (*GetChartDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChartDependenciesResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_GetChartDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmManagerServiceClient) EnsureWorkspaceNamespace(ctx context.Context, in *EnsureWorkspaceNamespaceRequest, opts ...grpc.CallOption) (*EnsureWorkspaceNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnsureWorkspaceNamespaceResponse)
+	err := c.cc.Invoke(ctx, HelmManagerService_EnsureWorkspaceNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HelmManagerServiceServer is the server API for HelmManagerService service.
 // All implementations must embed UnimplementedHelmManagerServiceServer
 // for forward compatibility.
@@ -243,6 +430,36 @@ type HelmManagerServiceServer interface {
 	ListChartVersions(context.Context, *ListChartVersionsRequest) (*ListChartVersionsResponse, error)
 	// 15. 获取应用列表
 	ListInstalledCharts(context.Context, *ListInstalledChartsRequest) (*ListInstalledChartsResponse, error)
+	// 16. 查询异步操作（安装/升级/卸载/回滚）
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// 17. 异步操作列表
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// 18. 取消异步操作
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
+	// 19. 获取已配置的仓库列表
+	ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error)
+	// 20. 删除仓库
+	RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoResponse, error)
+	// 21. 查询 OCI 仓库中 Chart 的 tag 列表
+	ListChartTags(context.Context, *ListChartTagsRequest) (*ListChartTagsResponse, error)
+	// 22. 获取 Chart 详情（默认 values、values schema、README、依赖）
+	GetChartDetails(context.Context, *GetChartDetailsRequest) (*GetChartDetailsResponse, error)
+	// 23. 预览升级：对比当前 release 与升级后的资源差异
+	DiffRelease(context.Context, *DiffReleaseRequest) (*DiffReleaseResponse, error)
+	// 24. 查询 release 历史版本
+	GetReleaseHistory(context.Context, *GetReleaseHistoryRequest) (*GetReleaseHistoryResponse, error)
+	// 25. 查询 release 的资源及健康状态
+	GetReleaseResources(context.Context, *GetReleaseResourcesRequest) (*GetReleaseResourcesResponse, error)
+	// 26. 流式上传 chart 包：第一条消息为元数据，之后为文件分片，支持按 upload_id 断点续传。
+	// 服务端收到元数据后立即通过响应头 upload-id、upload-offset 返回上传ID和已接收的字节数，
+	// 连接中断时客户端据此续传
+	UploadChart(grpc.ClientStreamingServer[UploadChartRequest, UploadChartResponse]) error
+	// 27. 解析 chart 的依赖树，并按给定的 values 计算 condition/tags
+	GetChartDependencies(context.Context, *GetChartDependenciesRequest) (*GetChartDependenciesResponse, error)
+	// 28. 检查当前调用方能否在指定命名空间调用某个接口，只做判定不执行操作
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	// 29. 获取工作空间/项目/环境对应的托管命名空间，首次使用时创建
+	EnsureWorkspaceNamespace(context.Context, *EnsureWorkspaceNamespaceRequest) (*EnsureWorkspaceNamespaceResponse, error)
 	mustEmbedUnimplementedHelmManagerServiceServer()
 }
 
@@ -292,6 +509,48 @@ func (UnimplementedHelmManagerServiceServer) ListChartVersions(context.Context,
 func (UnimplementedHelmManagerServiceServer) ListInstalledCharts(context.Context, *ListInstalledChartsRequest) (*ListInstalledChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInstalledCharts not implemented")
 }
+func (UnimplementedHelmManagerServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepos not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRepo not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) ListChartTags(context.Context, *ListChartTagsRequest) (*ListChartTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChartTags not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) GetChartDetails(context.Context, *GetChartDetailsRequest) (*GetChartDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChartDetails not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) DiffRelease(context.Context, *DiffReleaseRequest) (*DiffReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRelease not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) GetReleaseHistory(context.Context, *GetReleaseHistoryRequest) (*GetReleaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHistory not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) GetReleaseResources(context.Context, *GetReleaseResourcesRequest) (*GetReleaseResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseResources not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) UploadChart(grpc.ClientStreamingServer[UploadChartRequest, UploadChartResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadChart not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) GetChartDependencies(context.Context, *GetChartDependenciesRequest) (*GetChartDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChartDependencies not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedHelmManagerServiceServer) EnsureWorkspaceNamespace(context.Context, *EnsureWorkspaceNamespaceRequest) (*EnsureWorkspaceNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnsureWorkspaceNamespace not implemented")
+}
 func (UnimplementedHelmManagerServiceServer) mustEmbedUnimplementedHelmManagerServiceServer() {}
 func (UnimplementedHelmManagerServiceServer) testEmbeddedByValue()                            {}
 
@@ -540,6 +799,247 @@ func _HelmManagerService_ListInstalledCharts_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmManagerService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).ListRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_ListRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).ListRepos(ctx, req.(*ListReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_RemoveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).RemoveRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_RemoveRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).RemoveRepo(ctx, req.(*RemoveRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_ListChartTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChartTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).ListChartTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_ListChartTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).ListChartTags(ctx, req.(*ListChartTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_GetChartDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChartDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).GetChartDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_GetChartDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).GetChartDetails(ctx, req.(*GetChartDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_DiffRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).DiffRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_DiffRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).DiffRelease(ctx, req.(*DiffReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_GetReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).GetReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_GetReleaseHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).GetReleaseHistory(ctx, req.(*GetReleaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_GetReleaseResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).GetReleaseResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_GetReleaseResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).GetReleaseResources(ctx, req.(*GetReleaseResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_UploadChart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HelmManagerServiceServer).UploadChart(&grpc.GenericServerStream[UploadChartRequest, UploadChartResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HelmManagerService_UploadChartServer = grpc.ClientStreamingServer[UploadChartRequest, UploadChartResponse]
+
+func _HelmManagerService_GetChartDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChartDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).GetChartDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_GetChartDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).GetChartDependencies(ctx, req.(*GetChartDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmManagerService_EnsureWorkspaceNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsureWorkspaceNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmManagerServiceServer).EnsureWorkspaceNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmManagerService_EnsureWorkspaceNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmManagerServiceServer).EnsureWorkspaceNamespace(ctx, req.(*EnsureWorkspaceNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HelmManagerService_ServiceDesc is the grpc.ServiceDesc for HelmManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -595,6 +1095,58 @@ var HelmManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListInstalledCharts",
 			Handler:    _HelmManagerService_ListInstalledCharts_Handler,
 		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _HelmManagerService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _HelmManagerService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _HelmManagerService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "ListRepos",
+			Handler:    _HelmManagerService_ListRepos_Handler,
+		},
+		{
+			MethodName: "RemoveRepo",
+			Handler:    _HelmManagerService_RemoveRepo_Handler,
+		},
+		{
+			MethodName: "ListChartTags",
+			Handler:    _HelmManagerService_ListChartTags_Handler,
+		},
+		{
+			MethodName: "GetChartDetails",
+			Handler:    _HelmManagerService_GetChartDetails_Handler,
+		},
+		{
+			MethodName: "DiffRelease",
+			Handler:    _HelmManagerService_DiffRelease_Handler,
+		},
+		{
+			MethodName: "GetReleaseHistory",
+			Handler:    _HelmManagerService_GetReleaseHistory_Handler,
+		},
+		{
+			MethodName: "GetReleaseResources",
+			Handler:    _HelmManagerService_GetReleaseResources_Handler,
+		},
+		{
+			MethodName: "GetChartDependencies",
+			Handler:    _HelmManagerService_GetChartDependencies_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _HelmManagerService_CheckAccess_Handler,
+		},
+		{
+			MethodName: "EnsureWorkspaceNamespace",
+			Handler:    _HelmManagerService_EnsureWorkspaceNamespace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -602,6 +1154,11 @@ var HelmManagerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _HelmManagerService_WatchInstallStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UploadChart",
+			Handler:       _HelmManagerService_UploadChart_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "helm_service.proto",
 }
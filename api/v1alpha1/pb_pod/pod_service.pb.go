@@ -549,6 +549,7 @@ type TerminalMessage struct {
 	//	*TerminalMessage_SessionInfo
 	//	*TerminalMessage_Data
 	//	*TerminalMessage_Resize
+	//	*TerminalMessage_Exit
 	Payload       isTerminalMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -618,6 +619,15 @@ func (x *TerminalMessage) GetResize() *Resize {
 	return nil
 }
 
+func (x *TerminalMessage) GetExit() *TerminalExit {
+	if x != nil {
+		if x, ok := x.Payload.(*TerminalMessage_Exit); ok {
+			return x.Exit
+		}
+	}
+	return nil
+}
+
 type isTerminalMessage_Payload interface {
 	isTerminalMessage_Payload()
 }
@@ -634,12 +644,71 @@ type TerminalMessage_Resize struct {
 	Resize *Resize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"` // 客户端发送的窗口大小调整
 }
 
+type TerminalMessage_Exit struct {
+	Exit *TerminalExit `protobuf:"bytes,4,opt,name=exit,proto3,oneof"` // 服务端发送的会话结束信息
+}
+
 func (*TerminalMessage_SessionInfo) isTerminalMessage_Payload() {}
 
 func (*TerminalMessage_Data) isTerminalMessage_Payload() {}
 
 func (*TerminalMessage_Resize) isTerminalMessage_Payload() {}
 
+func (*TerminalMessage_Exit) isTerminalMessage_Payload() {}
+
+// 终端会话结束信息，服务端发送后关闭流
+type TerminalExit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // 进程退出码，非进程退出导致的结束为 -1
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                      // 结束原因（如 idle timeout）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalExit) Reset() {
+	*x = TerminalExit{}
+	mi := &file_pod_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalExit) ProtoMessage() {}
+
+func (x *TerminalExit) ProtoReflect() protoreflect.Message {
+	mi := &file_pod_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalExit.ProtoReflect.Descriptor instead.
+func (*TerminalExit) Descriptor() ([]byte, []int) {
+	return file_pod_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TerminalExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *TerminalExit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // 终端窗口大小
 type Resize struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -651,7 +720,7 @@ type Resize struct {
 
 func (x *Resize) Reset() {
 	*x = Resize{}
-	mi := &file_pod_service_proto_msgTypes[7]
+	mi := &file_pod_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +732,7 @@ func (x *Resize) String() string {
 func (*Resize) ProtoMessage() {}
 
 func (x *Resize) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[7]
+	mi := &file_pod_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +745,7 @@ func (x *Resize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resize.ProtoReflect.Descriptor instead.
 func (*Resize) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{7}
+	return file_pod_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Resize) GetWidth() uint32 {
@@ -708,7 +777,7 @@ type ConfigureHPARequest struct {
 
 func (x *ConfigureHPARequest) Reset() {
 	*x = ConfigureHPARequest{}
-	mi := &file_pod_service_proto_msgTypes[8]
+	mi := &file_pod_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +789,7 @@ func (x *ConfigureHPARequest) String() string {
 func (*ConfigureHPARequest) ProtoMessage() {}
 
 func (x *ConfigureHPARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[8]
+	mi := &file_pod_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +802,7 @@ func (x *ConfigureHPARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigureHPARequest.ProtoReflect.Descriptor instead.
 func (*ConfigureHPARequest) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{8}
+	return file_pod_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ConfigureHPARequest) GetNamespace() string {
@@ -789,7 +858,7 @@ type ConfigureHPAResponse struct {
 
 func (x *ConfigureHPAResponse) Reset() {
 	*x = ConfigureHPAResponse{}
-	mi := &file_pod_service_proto_msgTypes[9]
+	mi := &file_pod_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +870,7 @@ func (x *ConfigureHPAResponse) String() string {
 func (*ConfigureHPAResponse) ProtoMessage() {}
 
 func (x *ConfigureHPAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[9]
+	mi := &file_pod_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +883,7 @@ func (x *ConfigureHPAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigureHPAResponse.ProtoReflect.Descriptor instead.
 func (*ConfigureHPAResponse) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{9}
+	return file_pod_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ConfigureHPAResponse) GetMessage() string {
@@ -844,7 +913,7 @@ type ConfigureVPARequest struct {
 
 func (x *ConfigureVPARequest) Reset() {
 	*x = ConfigureVPARequest{}
-	mi := &file_pod_service_proto_msgTypes[10]
+	mi := &file_pod_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -856,7 +925,7 @@ func (x *ConfigureVPARequest) String() string {
 func (*ConfigureVPARequest) ProtoMessage() {}
 
 func (x *ConfigureVPARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[10]
+	mi := &file_pod_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +938,7 @@ func (x *ConfigureVPARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigureVPARequest.ProtoReflect.Descriptor instead.
 func (*ConfigureVPARequest) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{10}
+	return file_pod_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ConfigureVPARequest) GetNamespace() string {
@@ -911,7 +980,7 @@ type ConfigureVPAResponse struct {
 
 func (x *ConfigureVPAResponse) Reset() {
 	*x = ConfigureVPAResponse{}
-	mi := &file_pod_service_proto_msgTypes[11]
+	mi := &file_pod_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -923,7 +992,7 @@ func (x *ConfigureVPAResponse) String() string {
 func (*ConfigureVPAResponse) ProtoMessage() {}
 
 func (x *ConfigureVPAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[11]
+	mi := &file_pod_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,7 +1005,7 @@ func (x *ConfigureVPAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigureVPAResponse.ProtoReflect.Descriptor instead.
 func (*ConfigureVPAResponse) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{11}
+	return file_pod_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ConfigureVPAResponse) GetMessage() string {
@@ -969,7 +1038,7 @@ type CreateCanaryRequest struct {
 
 func (x *CreateCanaryRequest) Reset() {
 	*x = CreateCanaryRequest{}
-	mi := &file_pod_service_proto_msgTypes[12]
+	mi := &file_pod_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1050,7 @@ func (x *CreateCanaryRequest) String() string {
 func (*CreateCanaryRequest) ProtoMessage() {}
 
 func (x *CreateCanaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[12]
+	mi := &file_pod_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1063,7 @@ func (x *CreateCanaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCanaryRequest.ProtoReflect.Descriptor instead.
 func (*CreateCanaryRequest) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{12}
+	return file_pod_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateCanaryRequest) GetNamespace() string {
@@ -1057,7 +1126,7 @@ type CreateCanaryResponse struct {
 
 func (x *CreateCanaryResponse) Reset() {
 	*x = CreateCanaryResponse{}
-	mi := &file_pod_service_proto_msgTypes[13]
+	mi := &file_pod_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1138,7 @@ func (x *CreateCanaryResponse) String() string {
 func (*CreateCanaryResponse) ProtoMessage() {}
 
 func (x *CreateCanaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[13]
+	mi := &file_pod_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1151,7 @@ func (x *CreateCanaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCanaryResponse.ProtoReflect.Descriptor instead.
 func (*CreateCanaryResponse) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{13}
+	return file_pod_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateCanaryResponse) GetMessage() string {
@@ -1116,7 +1185,7 @@ type CreateBlueGreenRequest struct {
 
 func (x *CreateBlueGreenRequest) Reset() {
 	*x = CreateBlueGreenRequest{}
-	mi := &file_pod_service_proto_msgTypes[14]
+	mi := &file_pod_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1197,7 @@ func (x *CreateBlueGreenRequest) String() string {
 func (*CreateBlueGreenRequest) ProtoMessage() {}
 
 func (x *CreateBlueGreenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[14]
+	mi := &file_pod_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1210,7 @@ func (x *CreateBlueGreenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBlueGreenRequest.ProtoReflect.Descriptor instead.
 func (*CreateBlueGreenRequest) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{14}
+	return file_pod_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateBlueGreenRequest) GetNamespace() string {
@@ -1211,7 +1280,7 @@ type CreateBlueGreenResponse struct {
 
 func (x *CreateBlueGreenResponse) Reset() {
 	*x = CreateBlueGreenResponse{}
-	mi := &file_pod_service_proto_msgTypes[15]
+	mi := &file_pod_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1292,7 @@ func (x *CreateBlueGreenResponse) String() string {
 func (*CreateBlueGreenResponse) ProtoMessage() {}
 
 func (x *CreateBlueGreenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[15]
+	mi := &file_pod_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1305,7 @@ func (x *CreateBlueGreenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBlueGreenResponse.ProtoReflect.Descriptor instead.
 func (*CreateBlueGreenResponse) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{15}
+	return file_pod_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateBlueGreenResponse) GetMessage() string {
@@ -1264,7 +1333,7 @@ type PodsMetricsRequest struct {
 
 func (x *PodsMetricsRequest) Reset() {
 	*x = PodsMetricsRequest{}
-	mi := &file_pod_service_proto_msgTypes[16]
+	mi := &file_pod_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1276,7 +1345,7 @@ func (x *PodsMetricsRequest) String() string {
 func (*PodsMetricsRequest) ProtoMessage() {}
 
 func (x *PodsMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[16]
+	mi := &file_pod_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,7 +1358,7 @@ func (x *PodsMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PodsMetricsRequest.ProtoReflect.Descriptor instead.
 func (*PodsMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{16}
+	return file_pod_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PodsMetricsRequest) GetNamespace() string {
@@ -1318,7 +1387,7 @@ type PodMetricsData struct {
 
 func (x *PodMetricsData) Reset() {
 	*x = PodMetricsData{}
-	mi := &file_pod_service_proto_msgTypes[17]
+	mi := &file_pod_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +1399,7 @@ func (x *PodMetricsData) String() string {
 func (*PodMetricsData) ProtoMessage() {}
 
 func (x *PodMetricsData) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[17]
+	mi := &file_pod_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1412,7 @@ func (x *PodMetricsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PodMetricsData.ProtoReflect.Descriptor instead.
 func (*PodMetricsData) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{17}
+	return file_pod_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PodMetricsData) GetAppNum() int32 {
@@ -1386,7 +1455,7 @@ type PodsMetricsResponse struct {
 
 func (x *PodsMetricsResponse) Reset() {
 	*x = PodsMetricsResponse{}
-	mi := &file_pod_service_proto_msgTypes[18]
+	mi := &file_pod_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1398,7 +1467,7 @@ func (x *PodsMetricsResponse) String() string {
 func (*PodsMetricsResponse) ProtoMessage() {}
 
 func (x *PodsMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pod_service_proto_msgTypes[18]
+	mi := &file_pod_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,7 +1480,7 @@ func (x *PodsMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PodsMetricsResponse.ProtoReflect.Descriptor instead.
 func (*PodsMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_pod_service_proto_rawDescGZIP(), []int{18}
+	return file_pod_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PodsMetricsResponse) GetCode() int32 {
@@ -1492,12 +1561,16 @@ const file_pod_service_proto_rawDesc = "" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x19\n" +
 	"\bpod_name\x18\x02 \x01(\tR\apodName\x12\x1c\n" +
 	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x18\n" +
-	"\acommand\x18\x04 \x03(\tR\acommand\"\xaa\x01\n" +
+	"\acommand\x18\x04 \x03(\tR\acommand\"\xdc\x01\n" +
 	"\x0fTerminalMessage\x12F\n" +
 	"\fsession_info\x18\x01 \x01(\v2!.pod.v1alpha1.TerminalSessionInfoH\x00R\vsessionInfo\x12\x14\n" +
 	"\x04data\x18\x02 \x01(\fH\x00R\x04data\x12.\n" +
-	"\x06resize\x18\x03 \x01(\v2\x14.pod.v1alpha1.ResizeH\x00R\x06resizeB\t\n" +
-	"\apayload\"6\n" +
+	"\x06resize\x18\x03 \x01(\v2\x14.pod.v1alpha1.ResizeH\x00R\x06resize\x120\n" +
+	"\x04exit\x18\x04 \x01(\v2\x1a.pod.v1alpha1.TerminalExitH\x00R\x04exitB\t\n" +
+	"\apayload\"C\n" +
+	"\fTerminalExit\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"6\n" +
 	"\x06Resize\x12\x14\n" +
 	"\x05width\x18\x01 \x01(\rR\x05width\x12\x16\n" +
 	"\x06height\x18\x02 \x01(\rR\x06height\"\xde\x02\n" +
@@ -1606,7 +1679,7 @@ func file_pod_service_proto_rawDescGZIP() []byte {
 }
 
 var file_pod_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pod_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_pod_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_pod_service_proto_goTypes = []any{
 	(PodState)(0),                   // 0: pod.v1alpha1.PodState
 	(*Pod)(nil),                     // 1: pod.v1alpha1.Pod
@@ -1616,67 +1689,69 @@ var file_pod_service_proto_goTypes = []any{
 	(*LogChunk)(nil),                // 5: pod.v1alpha1.LogChunk
 	(*TerminalSessionInfo)(nil),     // 6: pod.v1alpha1.TerminalSessionInfo
 	(*TerminalMessage)(nil),         // 7: pod.v1alpha1.TerminalMessage
-	(*Resize)(nil),                  // 8: pod.v1alpha1.Resize
-	(*ConfigureHPARequest)(nil),     // 9: pod.v1alpha1.ConfigureHPARequest
-	(*ConfigureHPAResponse)(nil),    // 10: pod.v1alpha1.ConfigureHPAResponse
-	(*ConfigureVPARequest)(nil),     // 11: pod.v1alpha1.ConfigureVPARequest
-	(*ConfigureVPAResponse)(nil),    // 12: pod.v1alpha1.ConfigureVPAResponse
-	(*CreateCanaryRequest)(nil),     // 13: pod.v1alpha1.CreateCanaryRequest
-	(*CreateCanaryResponse)(nil),    // 14: pod.v1alpha1.CreateCanaryResponse
-	(*CreateBlueGreenRequest)(nil),  // 15: pod.v1alpha1.CreateBlueGreenRequest
-	(*CreateBlueGreenResponse)(nil), // 16: pod.v1alpha1.CreateBlueGreenResponse
-	(*PodsMetricsRequest)(nil),      // 17: pod.v1alpha1.PodsMetricsRequest
-	(*PodMetricsData)(nil),          // 18: pod.v1alpha1.PodMetricsData
-	(*PodsMetricsResponse)(nil),     // 19: pod.v1alpha1.PodsMetricsResponse
-	nil,                             // 20: pod.v1alpha1.Pod.LabelsEntry
-	nil,                             // 21: pod.v1alpha1.Pod.AnnotationsEntry
-	nil,                             // 22: pod.v1alpha1.ConfigureHPARequest.MetricsEntry
-	nil,                             // 23: pod.v1alpha1.ConfigureVPARequest.ResourcePoliciesEntry
-	nil,                             // 24: pod.v1alpha1.CreateCanaryRequest.SelectorEntry
-	nil,                             // 25: pod.v1alpha1.CreateCanaryRequest.TrafficRoutingEntry
-	nil,                             // 26: pod.v1alpha1.CreateBlueGreenRequest.SelectorEntry
-	(*timestamppb.Timestamp)(nil),   // 27: google.protobuf.Timestamp
+	(*TerminalExit)(nil),            // 8: pod.v1alpha1.TerminalExit
+	(*Resize)(nil),                  // 9: pod.v1alpha1.Resize
+	(*ConfigureHPARequest)(nil),     // 10: pod.v1alpha1.ConfigureHPARequest
+	(*ConfigureHPAResponse)(nil),    // 11: pod.v1alpha1.ConfigureHPAResponse
+	(*ConfigureVPARequest)(nil),     // 12: pod.v1alpha1.ConfigureVPARequest
+	(*ConfigureVPAResponse)(nil),    // 13: pod.v1alpha1.ConfigureVPAResponse
+	(*CreateCanaryRequest)(nil),     // 14: pod.v1alpha1.CreateCanaryRequest
+	(*CreateCanaryResponse)(nil),    // 15: pod.v1alpha1.CreateCanaryResponse
+	(*CreateBlueGreenRequest)(nil),  // 16: pod.v1alpha1.CreateBlueGreenRequest
+	(*CreateBlueGreenResponse)(nil), // 17: pod.v1alpha1.CreateBlueGreenResponse
+	(*PodsMetricsRequest)(nil),      // 18: pod.v1alpha1.PodsMetricsRequest
+	(*PodMetricsData)(nil),          // 19: pod.v1alpha1.PodMetricsData
+	(*PodsMetricsResponse)(nil),     // 20: pod.v1alpha1.PodsMetricsResponse
+	nil,                             // 21: pod.v1alpha1.Pod.LabelsEntry
+	nil,                             // 22: pod.v1alpha1.Pod.AnnotationsEntry
+	nil,                             // 23: pod.v1alpha1.ConfigureHPARequest.MetricsEntry
+	nil,                             // 24: pod.v1alpha1.ConfigureVPARequest.ResourcePoliciesEntry
+	nil,                             // 25: pod.v1alpha1.CreateCanaryRequest.SelectorEntry
+	nil,                             // 26: pod.v1alpha1.CreateCanaryRequest.TrafficRoutingEntry
+	nil,                             // 27: pod.v1alpha1.CreateBlueGreenRequest.SelectorEntry
+	(*timestamppb.Timestamp)(nil),   // 28: google.protobuf.Timestamp
 }
 var file_pod_service_proto_depIdxs = []int32{
 	0,  // 0: pod.v1alpha1.Pod.state:type_name -> pod.v1alpha1.PodState
-	27, // 1: pod.v1alpha1.Pod.start_time:type_name -> google.protobuf.Timestamp
-	20, // 2: pod.v1alpha1.Pod.labels:type_name -> pod.v1alpha1.Pod.LabelsEntry
-	21, // 3: pod.v1alpha1.Pod.annotations:type_name -> pod.v1alpha1.Pod.AnnotationsEntry
-	27, // 4: pod.v1alpha1.DeletePodResponse.deletion_timestamp:type_name -> google.protobuf.Timestamp
-	27, // 5: pod.v1alpha1.LogChunk.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 1: pod.v1alpha1.Pod.start_time:type_name -> google.protobuf.Timestamp
+	21, // 2: pod.v1alpha1.Pod.labels:type_name -> pod.v1alpha1.Pod.LabelsEntry
+	22, // 3: pod.v1alpha1.Pod.annotations:type_name -> pod.v1alpha1.Pod.AnnotationsEntry
+	28, // 4: pod.v1alpha1.DeletePodResponse.deletion_timestamp:type_name -> google.protobuf.Timestamp
+	28, // 5: pod.v1alpha1.LogChunk.timestamp:type_name -> google.protobuf.Timestamp
 	6,  // 6: pod.v1alpha1.TerminalMessage.session_info:type_name -> pod.v1alpha1.TerminalSessionInfo
-	8,  // 7: pod.v1alpha1.TerminalMessage.resize:type_name -> pod.v1alpha1.Resize
-	22, // 8: pod.v1alpha1.ConfigureHPARequest.metrics:type_name -> pod.v1alpha1.ConfigureHPARequest.MetricsEntry
-	27, // 9: pod.v1alpha1.ConfigureHPAResponse.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: pod.v1alpha1.ConfigureVPARequest.resource_policies:type_name -> pod.v1alpha1.ConfigureVPARequest.ResourcePoliciesEntry
-	27, // 11: pod.v1alpha1.ConfigureVPAResponse.created_at:type_name -> google.protobuf.Timestamp
-	24, // 12: pod.v1alpha1.CreateCanaryRequest.selector:type_name -> pod.v1alpha1.CreateCanaryRequest.SelectorEntry
-	25, // 13: pod.v1alpha1.CreateCanaryRequest.traffic_routing:type_name -> pod.v1alpha1.CreateCanaryRequest.TrafficRoutingEntry
-	27, // 14: pod.v1alpha1.CreateCanaryResponse.created_at:type_name -> google.protobuf.Timestamp
-	26, // 15: pod.v1alpha1.CreateBlueGreenRequest.selector:type_name -> pod.v1alpha1.CreateBlueGreenRequest.SelectorEntry
-	27, // 16: pod.v1alpha1.CreateBlueGreenResponse.created_at:type_name -> google.protobuf.Timestamp
-	18, // 17: pod.v1alpha1.PodsMetricsResponse.data:type_name -> pod.v1alpha1.PodMetricsData
-	2,  // 18: pod.v1alpha1.PodManagerService.DeletePod:input_type -> pod.v1alpha1.DeletePodRequest
-	4,  // 19: pod.v1alpha1.PodManagerService.GetPodLogs:input_type -> pod.v1alpha1.GetPodLogsRequest
-	7,  // 20: pod.v1alpha1.PodManagerService.ExecPodTerminal:input_type -> pod.v1alpha1.TerminalMessage
-	9,  // 21: pod.v1alpha1.PodManagerService.ConfigureHorizontalAutoscaling:input_type -> pod.v1alpha1.ConfigureHPARequest
-	11, // 22: pod.v1alpha1.PodManagerService.ConfigureVerticalAutoscaling:input_type -> pod.v1alpha1.ConfigureVPARequest
-	13, // 23: pod.v1alpha1.PodManagerService.CreateCanaryDeployment:input_type -> pod.v1alpha1.CreateCanaryRequest
-	15, // 24: pod.v1alpha1.PodManagerService.CreateBlueGreenDeployment:input_type -> pod.v1alpha1.CreateBlueGreenRequest
-	17, // 25: pod.v1alpha1.PodManagerService.PodsMetrics:input_type -> pod.v1alpha1.PodsMetricsRequest
-	3,  // 26: pod.v1alpha1.PodManagerService.DeletePod:output_type -> pod.v1alpha1.DeletePodResponse
-	5,  // 27: pod.v1alpha1.PodManagerService.GetPodLogs:output_type -> pod.v1alpha1.LogChunk
-	7,  // 28: pod.v1alpha1.PodManagerService.ExecPodTerminal:output_type -> pod.v1alpha1.TerminalMessage
-	10, // 29: pod.v1alpha1.PodManagerService.ConfigureHorizontalAutoscaling:output_type -> pod.v1alpha1.ConfigureHPAResponse
-	12, // 30: pod.v1alpha1.PodManagerService.ConfigureVerticalAutoscaling:output_type -> pod.v1alpha1.ConfigureVPAResponse
-	14, // 31: pod.v1alpha1.PodManagerService.CreateCanaryDeployment:output_type -> pod.v1alpha1.CreateCanaryResponse
-	16, // 32: pod.v1alpha1.PodManagerService.CreateBlueGreenDeployment:output_type -> pod.v1alpha1.CreateBlueGreenResponse
-	19, // 33: pod.v1alpha1.PodManagerService.PodsMetrics:output_type -> pod.v1alpha1.PodsMetricsResponse
-	26, // [26:34] is the sub-list for method output_type
-	18, // [18:26] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	9,  // 7: pod.v1alpha1.TerminalMessage.resize:type_name -> pod.v1alpha1.Resize
+	8,  // 8: pod.v1alpha1.TerminalMessage.exit:type_name -> pod.v1alpha1.TerminalExit
+	23, // 9: pod.v1alpha1.ConfigureHPARequest.metrics:type_name -> pod.v1alpha1.ConfigureHPARequest.MetricsEntry
+	28, // 10: pod.v1alpha1.ConfigureHPAResponse.created_at:type_name -> google.protobuf.Timestamp
+	24, // 11: pod.v1alpha1.ConfigureVPARequest.resource_policies:type_name -> pod.v1alpha1.ConfigureVPARequest.ResourcePoliciesEntry
+	28, // 12: pod.v1alpha1.ConfigureVPAResponse.created_at:type_name -> google.protobuf.Timestamp
+	25, // 13: pod.v1alpha1.CreateCanaryRequest.selector:type_name -> pod.v1alpha1.CreateCanaryRequest.SelectorEntry
+	26, // 14: pod.v1alpha1.CreateCanaryRequest.traffic_routing:type_name -> pod.v1alpha1.CreateCanaryRequest.TrafficRoutingEntry
+	28, // 15: pod.v1alpha1.CreateCanaryResponse.created_at:type_name -> google.protobuf.Timestamp
+	27, // 16: pod.v1alpha1.CreateBlueGreenRequest.selector:type_name -> pod.v1alpha1.CreateBlueGreenRequest.SelectorEntry
+	28, // 17: pod.v1alpha1.CreateBlueGreenResponse.created_at:type_name -> google.protobuf.Timestamp
+	19, // 18: pod.v1alpha1.PodsMetricsResponse.data:type_name -> pod.v1alpha1.PodMetricsData
+	2,  // 19: pod.v1alpha1.PodManagerService.DeletePod:input_type -> pod.v1alpha1.DeletePodRequest
+	4,  // 20: pod.v1alpha1.PodManagerService.GetPodLogs:input_type -> pod.v1alpha1.GetPodLogsRequest
+	7,  // 21: pod.v1alpha1.PodManagerService.ExecPodTerminal:input_type -> pod.v1alpha1.TerminalMessage
+	10, // 22: pod.v1alpha1.PodManagerService.ConfigureHorizontalAutoscaling:input_type -> pod.v1alpha1.ConfigureHPARequest
+	12, // 23: pod.v1alpha1.PodManagerService.ConfigureVerticalAutoscaling:input_type -> pod.v1alpha1.ConfigureVPARequest
+	14, // 24: pod.v1alpha1.PodManagerService.CreateCanaryDeployment:input_type -> pod.v1alpha1.CreateCanaryRequest
+	16, // 25: pod.v1alpha1.PodManagerService.CreateBlueGreenDeployment:input_type -> pod.v1alpha1.CreateBlueGreenRequest
+	18, // 26: pod.v1alpha1.PodManagerService.PodsMetrics:input_type -> pod.v1alpha1.PodsMetricsRequest
+	3,  // 27: pod.v1alpha1.PodManagerService.DeletePod:output_type -> pod.v1alpha1.DeletePodResponse
+	5,  // 28: pod.v1alpha1.PodManagerService.GetPodLogs:output_type -> pod.v1alpha1.LogChunk
+	7,  // 29: pod.v1alpha1.PodManagerService.ExecPodTerminal:output_type -> pod.v1alpha1.TerminalMessage
+	11, // 30: pod.v1alpha1.PodManagerService.ConfigureHorizontalAutoscaling:output_type -> pod.v1alpha1.ConfigureHPAResponse
+	13, // 31: pod.v1alpha1.PodManagerService.ConfigureVerticalAutoscaling:output_type -> pod.v1alpha1.ConfigureVPAResponse
+	15, // 32: pod.v1alpha1.PodManagerService.CreateCanaryDeployment:output_type -> pod.v1alpha1.CreateCanaryResponse
+	17, // 33: pod.v1alpha1.PodManagerService.CreateBlueGreenDeployment:output_type -> pod.v1alpha1.CreateBlueGreenResponse
+	20, // 34: pod.v1alpha1.PodManagerService.PodsMetrics:output_type -> pod.v1alpha1.PodsMetricsResponse
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_pod_service_proto_init() }
@@ -1688,6 +1763,7 @@ func file_pod_service_proto_init() {
 		(*TerminalMessage_SessionInfo)(nil),
 		(*TerminalMessage_Data)(nil),
 		(*TerminalMessage_Resize)(nil),
+		(*TerminalMessage_Exit)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1695,7 +1771,7 @@ func file_pod_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pod_service_proto_rawDesc), len(file_pod_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   26,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -16,13 +17,19 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	pb "jos-deployment/api/v1alpha1/pb"
 	podpb "jos-deployment/api/v1alpha1/pb_pod"
 	routepb "jos-deployment/api/v1alpha1/pb_routes"
 	"jos-deployment/handler/helm"
+	"jos-deployment/handler/pod"
 	"jos-deployment/handler/server"
+	"jos-deployment/pkg/db"
 	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/rbac"
+	"jos-deployment/pkg/tenancy"
+	"jos-deployment/pkg/terminal"
 )
 
 func init() {
@@ -32,14 +39,20 @@ func init() {
 
 // UploadResponse REST API 响应结构
 type UploadResponse struct {
-	Success      bool   `json:"success"`
-	Message      string `json:"message"`
-	ChartUrl     string `json:"chart_url,omitempty"`
-	SizeReceived uint64 `json:"size_received,omitempty"`
-	Digest       string `json:"digest,omitempty"`
+	Success      bool               `json:"success"`
+	Message      string             `json:"message"`
+	ChartUrl     string             `json:"chart_url,omitempty"`
+	ChartName    string             `json:"chart_name,omitempty"`
+	ChartVersion string             `json:"chart_version,omitempty"`
+	AppVersion   string             `json:"app_version,omitempty"`
+	SizeReceived uint64             `json:"size_received,omitempty"`
+	Digest       string             `json:"digest,omitempty"`
+	SignedBy     string             `json:"signed_by,omitempty"`
+	Lint         []helm.LintFinding `json:"lint,omitempty"`
 }
 
-// handleChartUpload 处理 Chart 文件上传的 REST API
+// handleChartUpload 处理 Chart 文件上传的 REST API。表单字段 chart 为 chart 包，
+// 可选的 prov 为签名文件；chart 名称和版本从包内的 Chart.yaml 读取
 func handleChartUpload(w http.ResponseWriter, r *http.Request) {
 	// 只允许 POST 请求
 	if r.Method != http.MethodPost {
@@ -47,12 +60,20 @@ func handleChartUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 解析 multipart form
+	// 限制请求体大小，额外预留 1MB 给签名文件和表单字段
+	maxSize := helm.MaxChartUploadSize()
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+1<<20)
 	err := r.ParseMultipartForm(32 << 20) // 32MB
 	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeErrorResponse(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Chart archive exceeds limit of %d bytes", maxSize))
+			return
+		}
 		writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Failed to parse multipart form: %v", err))
 		return
 	}
+	defer r.MultipartForm.RemoveAll()
 
 	// 获取上传的文件
 	file, header, err := r.FormFile("chart")
@@ -62,97 +83,106 @@ func handleChartUpload(w http.ResponseWriter, r *http.Request) {
 	}
 	defer file.Close()
 
-	//文件名字
-	fileName := header.Filename
-	fmt.Println("Uploaded file name:", fileName)
-
-	//chartName是fileName第一个'-'前面的字符串
-	chartName := strings.SplitN(fileName, "-", 2)[0]
-
-	var chartVersion string
-	repoName := "library" // 默认仓库名
-	//chartVersion 是 fileName第一个'-'和'.tgz'之间的字符串
-	if len(strings.SplitN(fileName, "-", 2)) > 1 {
-		chartVersion = strings.TrimSuffix(strings.SplitN(fileName, "-", 2)[1], ".tgz")
-	} else {
-		chartVersion = "1.0.0"
-	}
-
 	// 验证文件扩展名
 	if filepath.Ext(header.Filename) != ".tgz" {
 		writeErrorResponse(w, http.StatusBadRequest, "Only .tgz files are allowed")
 		return
 	}
+	repoName := "library" // 默认仓库名
 
-	// 创建临时文件
-	tempDir := filepath.Join(os.TempDir(), "helm-rest-uploads")
-	if err := os.MkdirAll(tempDir, 0755); err != nil {
+	// 上传的文件名不可信，临时文件使用随机名称
+	tempDir, err := os.MkdirTemp("", "helm-rest-upload")
+	if err != nil {
 		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to create temp directory: %v", err))
 		return
 	}
+	defer os.RemoveAll(tempDir) // 清理临时文件
 
-	chartFileName := fmt.Sprintf("%s-%s.tgz", chartName, chartVersion)
-	tempFilePath := filepath.Join(tempDir, chartFileName)
-
-	tempFile, err := os.Create(tempFilePath)
+	// 复制文件内容并计算 SHA256
+	tempFilePath := filepath.Join(tempDir, "chart.tgz")
+	hasher := sha256.New()
+	size, err := saveUploadedFile(tempFilePath, io.TeeReader(file, hasher))
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to create temp file: %v", err))
+		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to save file: %v", err))
 		return
 	}
-	defer func() {
-		tempFile.Close()
-		os.Remove(tempFilePath) // 清理临时文件
-	}()
 
-	// 复制文件内容并计算 SHA256
-	hasher := sha256.New()
-	multiWriter := io.MultiWriter(tempFile, hasher)
+	var provPath string
+	if prov, _, err := r.FormFile("prov"); err == nil {
+		defer prov.Close()
+		provPath = filepath.Join(tempDir, "chart.tgz.prov")
+		if _, err := saveUploadedFile(provPath, prov); err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to save provenance file: %v", err))
+			return
+		}
+	}
 
-	size, err := io.Copy(multiWriter, file)
+	validation, err := helm.ValidateChartArchive(tempFilePath, provPath)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to save file: %v", err))
+		response := UploadResponse{Success: false, Message: status.Convert(err).Message()}
+		if validation != nil {
+			response.Lint = validation.Lint
+		}
+		logger.L().Warn("Chart archive rejected", zap.String("file_name", header.Filename), zap.Error(err))
+		writeResponse(w, runtime.HTTPStatusFromCode(status.Code(err)), response)
 		return
 	}
+	meta := validation.Chart.Metadata
 
-	tempFile.Close() // 确保文件写入完成
-
-	// 使用 Helm 服务推送到 Harbor
-	chartUrl, err := helm.PushChartToHarbor(tempFilePath, repoName, chartFileName)
+	// 启用内置仓库时保存到内置仓库，否则推送到 Harbor
+	chartUrl, err := helm.PublishChart(tempFilePath, provPath, repoName, validation.FileName())
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to push to Harbor: %v", err))
+		writeErrorResponse(w, runtime.HTTPStatusFromCode(status.Code(err)), fmt.Sprintf("Failed to publish chart: %s", status.Convert(err).Message()))
 		return
 	}
 	// 返回成功响应
-	response := UploadResponse{
+	writeResponse(w, http.StatusOK, UploadResponse{
 		Success:      true,
 		Message:      "Chart uploaded successfully",
 		ChartUrl:     chartUrl,
+		ChartName:    meta.Name,
+		ChartVersion: meta.Version,
+		AppVersion:   meta.AppVersion,
 		SizeReceived: uint64(size),
 		Digest:       fmt.Sprintf("sha256:%x", hasher.Sum(nil)),
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(response)
+		SignedBy:     validation.SignedBy,
+		Lint:         validation.Lint,
+	})
 
 	logger.L().Info("Chart uploaded successfully via REST API",
-		zap.String("chart_name", chartName),
-		zap.String("chart_version", chartVersion),
+		zap.String("chart_name", meta.Name),
+		zap.String("chart_version", meta.Version),
 		zap.String("repo_name", repoName),
 		zap.String("file_size", fmt.Sprintf("%d", size)),
 	)
 }
 
-// writeErrorResponse 写入错误响应
-func writeErrorResponse(w http.ResponseWriter, statusCode int, message string) {
-	response := UploadResponse{
-		Success: false,
-		Message: message,
+// saveUploadedFile 把上传的内容写入 path，返回写入的字节数
+func saveUploadedFile(path string, r io.Reader) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	size, err := io.Copy(f, r)
+	if err != nil {
+		f.Close()
+		return size, err
 	}
+	return size, f.Close()
+}
 
+func writeResponse(w http.ResponseWriter, statusCode int, response UploadResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(response)
+}
+
+// writeErrorResponse 写入错误响应
+func writeErrorResponse(w http.ResponseWriter, statusCode int, message string) {
+	writeResponse(w, statusCode, UploadResponse{
+		Success: false,
+		Message: message,
+	})
 
 	logger.L().Error("REST API error",
 		zap.Int("status_code", statusCode),
@@ -173,13 +203,42 @@ func main() {
 	// }
 
 	defer logger.Sync()
-	server.Server()
+
+	// 初始化本地 sqlite，用于持久化异步操作；失败时操作记录只保存在内存中
+	sqlitePath := os.Getenv("SQLITE_DB_PATH")
+	if sqlitePath == "" {
+		sqlitePath = "./myapp.db"
+	}
+	if err := db.InitSqliteDB(sqlitePath); err != nil {
+		logger.L().Error("Failed to initialize sqlite database", zap.Error(err))
+	}
+	helm.InitOperations()
+	localRepo := helm.InitLocalRepo()
+
+	// 创建 JWT 拦截器，gRPC 服务和非网关的 HTTP 路由共用同一套认证配置
+	authn, err := server.NewJWTInterceptorFromEnv()
+	if err != nil {
+		log.Fatal("Failed to initialize JWT authentication: ", err)
+	}
+	// 配置了 RBAC 策略时按角色授权
+	authorizer, err := rbac.InitFromEnv()
+	if err != nil {
+		log.Fatal("Failed to initialize RBAC: ", err)
+	}
+	authz := server.NewRBACInterceptor(authorizer)
+	// 启用工作空间隔离时只允许访问所属工作空间的托管命名空间
+	tenants, err := tenancy.InitFromEnv()
+	if err != nil {
+		log.Fatal("Failed to initialize workspace isolation: ", err)
+	}
+	isolation := server.NewTenancyInterceptor(tenants)
+	server.Server(authn, authz, isolation)
 
 	// 启动 HTTP 网关
 	ctx := context.Background()
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	err := pb.RegisterHelmManagerServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
+	err = pb.RegisterHelmManagerServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
 	if err != nil {
 		log.Fatal("Failed to register gRPC handler:", err)
 	}
@@ -196,11 +255,28 @@ func main() {
 	// 添加自定义 REST API 路由
 	httpMux := http.NewServeMux()
 
-	// 将 gRPC Gateway 路由挂载到根路径
+	// 将 gRPC Gateway 路由挂载到根路径，认证由 gRPC 拦截器完成
 	httpMux.Handle("/", mux)
 
+	// 健康检查，默认在免认证列表中
+	httpMux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	// 内置 chart 仓库，外部客户端需携带 token 访问（helm 客户端以 token 作为 Basic 认证密码）；
+	// HelmClient 使用本机监听地址
+	if localRepo != nil {
+		httpMux.Handle(helm.LocalRepoPath, authn.ChartRepoMiddleware(http.StripPrefix(strings.TrimSuffix(helm.LocalRepoPath, "/"), localRepo)))
+	}
+
 	// 添加文件上传 REST API
-	httpMux.HandleFunc("/prod/v1alpha1/chart/upload", handleChartUpload)
+	httpMux.Handle("/prod/v1alpha1/chart/upload", authn.HTTPMiddleware(
+		authz.HTTPMiddleware(pb.HelmManagerService_UploadChart_FullMethodName, http.HandlerFunc(handleChartUpload))))
+
+	// 添加浏览器终端 WebSocket 入口（grpc-gateway 无法承载双向流）
+	httpMux.Handle(terminal.WebSocketPattern, authn.HTTPMiddleware(
+		authz.HTTPMiddleware(podpb.PodManagerService_ExecPodTerminal_FullMethodName,
+			isolation.HTTPMiddleware(http.HandlerFunc((&pod.PodManagerServer{}).ServeTerminalWebSocket)))))
 
 	log.Println("gRPC server on :50051, HTTP gateway on :8080")
 	http.ListenAndServe(":8080", httpMux)
@@ -0,0 +1,135 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	deletionDeleted  = "deleted"
+	deletionNotFound = "not_found"
+	deletionFailed   = "failed"
+
+	kindPersistentVolumeClaim = "PersistentVolumeClaim"
+)
+
+// cleanupResource 卸载后按 release 标签清理的资源类型
+type cleanupResource struct {
+	kind string
+	gvr  schema.GroupVersionResource
+}
+
+// cleanupResources 按删除顺序排列：先删工作负载，最后删数据卷
+var cleanupResources = []cleanupResource{
+	{"Deployment", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}},
+	{"StatefulSet", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}},
+	{"DaemonSet", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}},
+	{"Service", schema.GroupVersionResource{Version: "v1", Resource: "services"}},
+	{"Ingress", schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}},
+	{"ApisixRoute", schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixroutes"}},
+	{"ConfigMap", schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+	{"Secret", schema.GroupVersionResource{Version: "v1", Resource: "secrets"}},
+	{kindPersistentVolumeClaim, schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}},
+}
+
+// cleanupKinds 返回本次需要清理的资源类型。未指定时清理全部类型，
+// PVC 会删除业务数据，只有显式设置 delete_pvcs 时才清理
+func cleanupKinds(req *pb.UninstallChartRequest) ([]cleanupResource, error) {
+	requested := map[string]bool{}
+	for _, kind := range req.GetCleanupKinds() {
+		found := false
+		for _, r := range cleanupResources {
+			if strings.EqualFold(kind, r.kind) {
+				requested[r.kind] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported cleanup kind %q", kind)
+		}
+	}
+	if requested[kindPersistentVolumeClaim] && !req.GetDeletePvcs() {
+		return nil, status.Errorf(codes.InvalidArgument, "delete_pvcs must be set to clean up PersistentVolumeClaims")
+	}
+
+	var kinds []cleanupResource
+	for _, r := range cleanupResources {
+		if r.kind == kindPersistentVolumeClaim && !req.GetDeletePvcs() {
+			continue
+		}
+		if len(requested) > 0 && !requested[r.kind] {
+			continue
+		}
+		kinds = append(kinds, r)
+	}
+	return kinds, nil
+}
+
+// cleanupReleaseResources 删除带有 app.kubernetes.io/instance=<release> 标签的残留资源，
+// 返回每个资源的删除结果和失败数量
+func cleanupReleaseResources(ctx context.Context, dyn dynamic.Interface, namespace, releaseName string, kinds []cleanupResource) ([]*pb.ResourceDeletion, int) {
+	labelSelector := fmt.Sprintf("app.kubernetes.io/instance=%s", releaseName)
+	deletePolicy := metav1.DeletePropagationForeground
+
+	var deletions []*pb.ResourceDeletion
+	failed := 0
+	for _, r := range kinds {
+		client := dyn.Resource(r.gvr).Namespace(namespace)
+		list, err := client.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			// 集群中没有该 CRD（如未安装 APISIX）时跳过
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			logger.L().Error("Failed to list resources", zap.String("kind", r.kind), zap.Error(err))
+			deletions = append(deletions, &pb.ResourceDeletion{
+				Kind:      r.kind,
+				Namespace: namespace,
+				Result:    deletionFailed,
+				Error:     fmt.Sprintf("list failed: %v", err),
+			})
+			failed++
+			continue
+		}
+
+		for _, item := range list.Items {
+			// helm 自身保存 release 历史的 Secret 由 uninstall 处理（keep_history 时需要保留）
+			if r.kind == "Secret" && item.GetLabels()["owner"] == "helm" {
+				continue
+			}
+			deletion := &pb.ResourceDeletion{
+				Kind:      r.kind,
+				Name:      item.GetName(),
+				Namespace: namespace,
+				Result:    deletionDeleted,
+			}
+			err := client.Delete(ctx, item.GetName(), metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+			switch {
+			case apierrors.IsNotFound(err):
+				deletion.Result = deletionNotFound
+			case err != nil:
+				logger.L().Error("Failed to delete resource", zap.String("kind", r.kind), zap.String("name", item.GetName()), zap.Error(err))
+				deletion.Result = deletionFailed
+				deletion.Error = err.Error()
+				failed++
+			default:
+				logger.L().Info("Resource deleted", zap.String("kind", r.kind), zap.String("name", item.GetName()), zap.String("namespace", namespace))
+			}
+			deletions = append(deletions, deletion)
+		}
+	}
+	return deletions, failed
+}
@@ -0,0 +1,372 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/operation"
+)
+
+// dependencyCacheDir 下载依赖后的 chart 目录缓存，按 chart 包的 sha256 区分
+func dependencyCacheDir() string {
+	return filepath.Join(filepath.Dir(helmClient.settings.RepositoryCache), "dependencies")
+}
+
+// loadChart 加载 chart 包，Chart.yaml 中声明但 charts/ 目录中缺少的依赖从已配置的仓库下载
+func loadChart(ctx context.Context, chartPath string) (*chart.Chart, error) {
+	ch, _, err := resolveChartDependencies(ctx, chartPath)
+	return ch, err
+}
+
+// resolveChartDependencies 与 helm dependency build 一致：有 Chart.lock 时按锁定的版本下载，
+// 并校验 Chart.lock 与 Chart.yaml 是否一致；没有时按版本约束解析。依赖只能来自已配置的仓库。
+// 有 Chart.lock 的 chart 解析结果是确定的，缓存到 dependencyCacheDir 中复用。
+// 返回加载后的 chart 以及从仓库下载的依赖名称
+func resolveChartDependencies(ctx context.Context, chartPath string) (*chart.Chart, map[string]bool, error) {
+	ch, err := loader.Load(chartPath)
+	if err != nil {
+		logger.L().Error("Failed to load chart", zap.Error(err))
+		return nil, nil, status.Errorf(codes.Internal, "load chart failed: %v", err)
+	}
+	missing := missingDependencies(ch)
+	if len(missing) == 0 {
+		return ch, nil, nil
+	}
+	downloaded := map[string]bool{}
+	for _, dep := range missing {
+		downloaded[dep.Name] = true
+	}
+	if fi, err := os.Stat(chartPath); err != nil || fi.IsDir() {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "chart %s is missing dependencies: %s", ch.Name(), dependencyNames(missing))
+	}
+	if err := checkDependencyRepos(ch.Metadata.Dependencies); err != nil {
+		return nil, nil, err
+	}
+
+	cacheDir := dependencyCacheDir()
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "create dependency cache failed: %v", err)
+	}
+	var cachePath string
+	if ch.Lock != nil {
+		digest, err := provenance.DigestFile(chartPath)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "digest chart failed: %v", err)
+		}
+		cachePath = filepath.Join(cacheDir, digest)
+		if cached, err := loader.Load(filepath.Join(cachePath, ch.Name())); err == nil && len(missingDependencies(cached)) == 0 {
+			logger.L().Info("Using cached chart dependencies", zap.String("chart", ch.Name()), zap.String("digest", digest))
+			return cached, downloaded, nil
+		}
+	}
+
+	workDir, err := os.MkdirTemp(cacheDir, ".build-")
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "create temp directory failed: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+	if err := chartutil.ExpandFile(workDir, chartPath); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "expand chart failed: %v", err)
+	}
+	chartDir := filepath.Join(workDir, ch.Name())
+
+	operation.Report(ctx, "Resolving dependencies "+dependencyNames(missing))
+	var out bytes.Buffer
+	manager, err := newDependencyManager(workDir, chartDir, ch.Metadata.Dependencies, &out)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = manager.Build()
+	// 仓库配置中包含凭据，不能留在缓存目录中
+	os.Remove(manager.RepositoryConfig)
+	if err != nil {
+		logger.L().Error("Failed to resolve chart dependencies", zap.String("chart", ch.Name()), zap.String("output", out.String()), zap.Error(err))
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "resolve dependencies of chart %s failed: %v", ch.Name(), err)
+	}
+
+	resolved, err := loader.Load(chartDir)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "load chart with dependencies failed: %v", err)
+	}
+	if err := action.CheckDependencies(resolved, resolved.Metadata.Dependencies); err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	// 没有 Chart.lock 时 Manager 会生成一个，返回的 chart 保持原包的状态
+	resolved.Lock = ch.Lock
+	if cachePath != "" {
+		// 并发解析同一个 chart 时只有一个能重命名成功，其余的直接丢弃
+		if err := os.Rename(workDir, cachePath); err != nil && !os.IsExist(err) {
+			logger.L().Warn("Failed to cache chart dependencies", zap.Error(err))
+		}
+	}
+	logger.L().Info("Chart dependencies resolved", zap.String("chart", ch.Name()), zap.String("dependencies", dependencyNames(missing)))
+	return resolved, downloaded, nil
+}
+
+// missingDependencies 返回 Chart.yaml 中声明但 charts/ 目录中没有的依赖
+func missingDependencies(ch *chart.Chart) []*chart.Dependency {
+	var missing []*chart.Dependency
+	for _, dep := range ch.Metadata.Dependencies {
+		if findSubchart(ch, dep) == nil {
+			missing = append(missing, dep)
+		}
+	}
+	return missing
+}
+
+// findSubchart 与 helm 相同，按名称和版本约束匹配 charts/ 中的子 chart
+func findSubchart(ch *chart.Chart, dep *chart.Dependency) *chart.Chart {
+	for _, sub := range ch.Dependencies() {
+		if sub.Name() == dep.Name && chartutil.IsCompatibleRange(dep.Version, sub.Metadata.Version) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func dependencyNames(deps []*chart.Dependency) string {
+	names := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		names = append(names, dep.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// checkDependencyRepos 依赖只能来自已配置的仓库，不允许像 helm 命令行那样临时添加未知仓库
+func checkDependencyRepos(deps []*chart.Dependency) error {
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	for _, dep := range deps {
+		if dep.Repository == "" || strings.HasPrefix(dep.Repository, "file://") || registry.IsOCI(dep.Repository) {
+			continue
+		}
+		if findDependencyRepo(repoFile, dep.Repository) == nil {
+			return status.Errorf(codes.FailedPrecondition, "dependency %s uses repository %q which is not configured", dep.Name, dep.Repository)
+		}
+	}
+	return nil
+}
+
+// findDependencyRepo 按 @name、alias:name 或仓库地址查找已配置的仓库
+func findDependencyRepo(repoFile *repo.File, repository string) *repo.Entry {
+	if name, ok := strings.CutPrefix(repository, "@"); ok {
+		return repoFile.Get(name)
+	}
+	if name, ok := strings.CutPrefix(repository, "alias:"); ok {
+		return repoFile.Get(name)
+	}
+	for _, entry := range repoFile.Repositories {
+		if strings.TrimSuffix(entry.URL, "/") == strings.TrimSuffix(repository, "/") {
+			return entry
+		}
+	}
+	return nil
+}
+
+// newDependencyManager 创建下载依赖的 downloader.Manager。Manager 会读取配置中所有仓库的索引，
+// 这里只把依赖用到的仓库写入 workDir 下单独的 repositories.yaml，并先通过 repoIndexes 刷新这些仓库的索引，
+// 无法访问的无关仓库不会影响解析。OCI 依赖按 registry 分别使用对应仓库配置的凭据。配置了 CHART_VERIFY_KEYRING 时校验依赖的签名
+func newDependencyManager(workDir, chartDir string, deps []*chart.Dependency, out io.Writer) (*downloader.Manager, error) {
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	depRepos := repo.NewFile()
+	hosts := make(map[string]*repo.Entry)
+	for _, dep := range deps {
+		if dep.Repository == "" || strings.HasPrefix(dep.Repository, "file://") {
+			continue
+		}
+		if registry.IsOCI(dep.Repository) {
+			host := ociHost(dep.Repository)
+			if _, ok := hosts[host]; !ok {
+				hosts[host] = registryEntryForHost(host)
+			}
+			continue
+		}
+		entry := findDependencyRepo(repoFile, dep.Repository)
+		if entry == nil || depRepos.Has(entry.Name) {
+			continue
+		}
+		if err := refreshChartRepository(entry); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "refresh repository %s failed: %v", entry.Name, err)
+		}
+		depRepos.Add(entry)
+	}
+	var registryClient *registry.Client
+	if len(hosts) > 0 {
+		// 依赖可能来自多个 registry，每个 registry 使用各自的凭据和 TLS 设置
+		registryClient, err = newRegistriesClient(hosts)
+	} else {
+		registryClient, err = registry.NewClient()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create registry client failed: %v", err)
+	}
+	repoConfig := filepath.Join(workDir, "repositories.yaml")
+	if err := depRepos.WriteFile(repoConfig, 0600); err != nil {
+		return nil, status.Errorf(codes.Internal, "write repository file failed: %v", err)
+	}
+
+	manager := &downloader.Manager{
+		Out:              out,
+		ChartPath:        chartDir,
+		SkipUpdate:       true,
+		Getters:          getter.All(helmClient.settings),
+		RegistryClient:   registryClient,
+		RepositoryConfig: repoConfig,
+		RepositoryCache:  helmClient.settings.RepositoryCache,
+	}
+	if keyring := os.Getenv("CHART_VERIFY_KEYRING"); keyring != "" {
+		manager.Verify = downloader.VerifyIfPossible
+		manager.Keyring = keyring
+	}
+	return manager, nil
+}
+
+func (s *HelmManagerServer) GetChartDependencies(ctx context.Context, req *pb.GetChartDependenciesRequest) (*pb.GetChartDependenciesResponse, error) {
+	logger.L().Info("GetChartDependencies called", zap.String("request", req.String()))
+	if req.GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart_name is required")
+	}
+	values, err := chartSpecValues(&pb.ChartSpec{ValuesYaml: req.GetValuesYaml(), Set: req.GetSet()})
+	if err != nil {
+		return nil, err
+	}
+
+	chartPath, err := locateChart(req.GetRepoName(), req.GetChartName(), req.GetVersion())
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.NotFound, "locate chart failed: %v", err)
+	}
+	ch, downloaded, err := resolveChartDependencies(ctx, chartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := dependencyTree(ch, values, "", downloaded)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "evaluate dependencies failed: %v", err)
+	}
+	return &pb.GetChartDependenciesResponse{
+		Code:         0,
+		Message:      fmt.Sprintf("Found %d dependencies", len(nodes)),
+		Success:      true,
+		ChartName:    ch.Name(),
+		ChartVersion: ch.Metadata.Version,
+		Locked:       ch.Lock != nil,
+		Dependencies: nodes,
+	}, nil
+}
+
+// dependencyTree 按 helm 安装时的规则计算每个依赖是否启用：先看 tags，condition 优先级更高；
+// 子 chart 以别名参与 values 合并，condition 路径相对于父 chart。被禁用的依赖不再计算其子依赖
+func dependencyTree(ch *chart.Chart, values chartutil.Values, path string, downloaded map[string]bool) ([]*pb.DependencyNode, error) {
+	// 与 helm 一致，合并 values 前先把子 chart 替换为别名，避免修改原 chart 使用副本
+	view := *ch
+	subcharts := map[*chart.Dependency]*chart.Chart{}
+	var aliased []*chart.Chart
+	for _, dep := range ch.Metadata.Dependencies {
+		if sub := findSubchart(ch, dep); sub != nil {
+			c := *sub
+			md := *sub.Metadata
+			if dep.Alias != "" {
+				md.Name = dep.Alias
+			}
+			c.Metadata = &md
+			subcharts[dep] = &c
+			aliased = append(aliased, &c)
+		}
+	}
+	view.SetDependencies(aliased...)
+	cvals, err := chartutil.CoalesceValues(&view, values)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*pb.DependencyNode, 0, len(ch.Metadata.Dependencies))
+	for _, dep := range ch.Metadata.Dependencies {
+		node := &pb.DependencyNode{
+			Name:       dep.Name,
+			Alias:      dep.Alias,
+			Version:    dep.Version,
+			Repository: dep.Repository,
+			Condition:  dep.Condition,
+			Tags:       dep.Tags,
+		}
+		node.Enabled, node.Reason = dependencyEnabled(dep, cvals, path)
+		sub := subcharts[dep]
+		if sub == nil {
+			node.Missing = true
+			nodes = append(nodes, node)
+			continue
+		}
+		node.ResolvedVersion = sub.Metadata.Version
+		node.Vendored = !downloaded[dep.Name]
+		if node.Enabled {
+			if node.Dependencies, err = dependencyTree(sub, cvals, path+sub.Name()+".", nil); err != nil {
+				return nil, err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// dependencyEnabled 返回依赖是否启用及依据，规则与 chartutil.ProcessDependencies 相同
+func dependencyEnabled(dep *chart.Dependency, cvals chartutil.Values, path string) (bool, string) {
+	enabled, reason := true, "enabled by default"
+	if tags, err := cvals.Table("tags"); err == nil {
+		var trueTag, falseTag string
+		for _, tag := range dep.Tags {
+			b, ok := tags[tag].(bool)
+			switch {
+			case !ok:
+			case b && trueTag == "":
+				trueTag = tag
+			case !b && falseTag == "":
+				falseTag = tag
+			}
+		}
+		if trueTag != "" {
+			reason = fmt.Sprintf("tag %s=true", trueTag)
+		} else if falseTag != "" {
+			enabled, reason = false, fmt.Sprintf("tag %s=false", falseTag)
+		}
+	}
+	for _, cond := range strings.Split(strings.TrimSpace(dep.Condition), ",") {
+		if cond == "" {
+			continue
+		}
+		if v, err := cvals.PathValue(path + cond); err == nil {
+			if b, ok := v.(bool); ok {
+				return b, fmt.Sprintf("condition %s=%t", cond, b)
+			}
+		}
+	}
+	return enabled, reason
+}
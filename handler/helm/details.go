@@ -0,0 +1,183 @@
+package helm
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/registry"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// maxCachedChartDetails 详情缓存的最大条目数，超过后随机淘汰
+const maxCachedChartDetails = 256
+
+// chartDetailsCache 按 chart 包的 sha256 缓存解析后的详情，同一个包内容不会变化，无需过期
+var chartDetailsCache = struct {
+	sync.RWMutex
+	items map[string]*pb.ChartDetails
+}{items: map[string]*pb.ChartDetails{}}
+
+func getCachedChartDetails(digest string) *pb.ChartDetails {
+	if digest == "" {
+		return nil
+	}
+	chartDetailsCache.RLock()
+	defer chartDetailsCache.RUnlock()
+	return chartDetailsCache.items[digest]
+}
+
+func putCachedChartDetails(details *pb.ChartDetails) {
+	chartDetailsCache.Lock()
+	defer chartDetailsCache.Unlock()
+	if len(chartDetailsCache.items) >= maxCachedChartDetails {
+		for k := range chartDetailsCache.items {
+			delete(chartDetailsCache.items, k)
+			break
+		}
+	}
+	chartDetailsCache.items[details.Digest] = details
+}
+
+func (s *HelmManagerServer) GetChartDetails(ctx context.Context, req *pb.GetChartDetailsRequest) (*pb.GetChartDetailsResponse, error) {
+	logger.L().Info("GetChartDetails called", zap.String("request", req.String()))
+	if req.GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart_name is required")
+	}
+
+	// 普通仓库可以从索引拿到 digest，命中缓存时不必下载 chart 包
+	if !registry.IsOCI(req.GetChartName()) {
+		entry, err := getRepoEntry(req.GetRepoName())
+		if err != nil {
+			return nil, err
+		}
+		if !registry.IsOCI(entry.URL) {
+			indexFile, err := repoIndexes.Get(entry)
+			if err != nil {
+				logger.L().Error("Failed to load repository index", zap.Error(err))
+				return nil, status.Errorf(codes.Internal, "load repository index failed: %v", err)
+			}
+			cv, err := indexFile.Get(req.GetChartName(), req.GetVersion())
+			if err != nil {
+				return nil, status.Errorf(codes.NotFound, "chart %q version %q not found in repository %q: %v",
+					req.GetChartName(), req.GetVersion(), entry.Name, err)
+			}
+			if details := getCachedChartDetails(cv.Digest); details != nil {
+				return chartDetailsResponse(details), nil
+			}
+		}
+	}
+
+	chartPath, err := locateChart(req.GetRepoName(), req.GetChartName(), req.GetVersion())
+	if err != nil {
+		logger.L().Error("Failed to locate chart", zap.Error(err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.NotFound, "locate chart failed: %v", err)
+	}
+	digest, err := provenance.DigestFile(chartPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "digest chart failed: %v", err)
+	}
+	if details := getCachedChartDetails(digest); details != nil {
+		return chartDetailsResponse(details), nil
+	}
+
+	ch, err := loader.Load(chartPath)
+	if err != nil {
+		logger.L().Error("Failed to load chart", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "load chart failed: %v", err)
+	}
+	details := toPbChartDetails(ch)
+	details.Digest = digest
+	putCachedChartDetails(details)
+
+	logger.L().Info("Chart details loaded", zap.String("chart", ch.Name()), zap.String("version", ch.Metadata.Version))
+	return chartDetailsResponse(details), nil
+}
+
+// locateChart 与安装时相同的方式解析并下载 chart，返回本地 chart 包路径
+func locateChart(repoName, chartName, version string) (string, error) {
+	chartRef, registryClient, err := resolveChartRef(repoName, chartName)
+	if err != nil {
+		return "", err
+	}
+	install := action.NewInstall(&action.Configuration{RegistryClient: registryClient})
+	install.Version = version
+	install.ChartPathOptions.InsecureSkipTLSverify = true
+	return install.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
+}
+
+func chartDetailsResponse(details *pb.ChartDetails) *pb.GetChartDetailsResponse {
+	return &pb.GetChartDetailsResponse{
+		Code:    0,
+		Message: "Chart details retrieved successfully",
+		Success: true,
+		Data:    details,
+	}
+}
+
+func toPbChartDetails(ch *chart.Chart) *pb.ChartDetails {
+	md := ch.Metadata
+	details := &pb.ChartDetails{
+		Name:         md.Name,
+		Version:      md.Version,
+		AppVersion:   md.AppVersion,
+		Description:  md.Description,
+		ApiVersion:   md.APIVersion,
+		Type:         md.Type,
+		KubeVersion:  md.KubeVersion,
+		Home:         md.Home,
+		Icon:         md.Icon,
+		Keywords:     md.Keywords,
+		Sources:      md.Sources,
+		Deprecated:   md.Deprecated,
+		Annotations:  md.Annotations,
+		ValuesSchema: string(ch.Schema),
+	}
+	for _, m := range md.Maintainers {
+		details.Maintainers = append(details.Maintainers, &pb.ChartMaintainer{Name: m.Name, Email: m.Email, Url: m.URL})
+	}
+
+	// values.yaml 返回原文以保留注释，loader 把它放在 Raw 中
+	for _, f := range ch.Raw {
+		if f.Name == chartutil.ValuesfileName {
+			details.Values = string(f.Data)
+			break
+		}
+	}
+	for _, f := range ch.Files {
+		if strings.EqualFold(f.Name, "README.md") {
+			details.Readme = string(f.Data)
+			break
+		}
+	}
+
+	vendored := map[string]bool{}
+	for _, sub := range ch.Dependencies() {
+		vendored[sub.Name()] = true
+	}
+	for _, dep := range md.Dependencies {
+		details.Dependencies = append(details.Dependencies, &pb.ChartDependency{
+			Name:       dep.Name,
+			Version:    dep.Version,
+			Repository: dep.Repository,
+			Condition:  dep.Condition,
+			Tags:       dep.Tags,
+			Alias:      dep.Alias,
+			Vendored:   vendored[dep.Name],
+		})
+	}
+	return details
+}
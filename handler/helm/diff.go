@@ -0,0 +1,383 @@
+package helm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	changeAdded     = "added"
+	changeRemoved   = "removed"
+	changeChanged   = "changed"
+	changeUnchanged = "unchanged"
+
+	defaultDiffContext = 3
+)
+
+// immutableFields 各类资源创建后不能修改的字段，修改后升级会失败，只能删除重建
+var immutableFields = map[string][][]string{
+	"Deployment":            {{"spec", "selector"}},
+	"ReplicaSet":            {{"spec", "selector"}},
+	"DaemonSet":             {{"spec", "selector"}},
+	"StatefulSet":           {{"spec", "selector"}, {"spec", "serviceName"}, {"spec", "volumeClaimTemplates"}, {"spec", "podManagementPolicy"}},
+	"Job":                   {{"spec", "selector"}, {"spec", "template"}, {"spec", "completionMode"}},
+	"Service":               {{"spec", "clusterIP"}},
+	"PersistentVolumeClaim": {{"spec", "storageClassName"}, {"spec", "accessModes"}, {"spec", "volumeName"}, {"spec", "selector"}, {"spec", "volumeMode"}},
+	"Secret":                {{"type"}},
+}
+
+// manifestResource manifest 中的单个资源
+type manifestResource struct {
+	obj  *unstructured.Unstructured
+	doc  string // 规范化后的 yaml，字段顺序固定，便于对比
+	yaml string // 原始 yaml，用于查询集群中的实际资源
+}
+
+func (s *HelmManagerServer) DiffRelease(ctx context.Context, req *pb.DiffReleaseRequest) (*pb.DiffReleaseResponse, error) {
+	logger.L().Info("DiffRelease called", zap.String("request", req.String()))
+	if req.GetReleaseName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "release_name is required")
+	}
+	if req.GetChart().GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart.chart_name is required")
+	}
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = "default"
+	}
+	contextLines := int(req.GetContextLines())
+	if contextLines <= 0 {
+		contextLines = defaultDiffContext
+	}
+
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	current, err := action.NewGet(actionConfig).Run(req.GetReleaseName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "release %q not found in namespace %q: %v", req.GetReleaseName(), namespace, err)
+	}
+
+	// 与 UpgradeChart 相同的方式渲染升级后的 release，server 模式的 dry run 不会修改集群
+	upgrade, chart, err := newUpgradeAction(ctx, namespace, req.GetChart())
+	if err != nil {
+		return nil, err
+	}
+	upgrade.DryRun = true
+	upgrade.DryRunOption = "server"
+	if err := applyValuesStrategy(upgrade, req.GetReuseValues(), req.GetResetValues(), req.GetResetThenReuseValues()); err != nil {
+		return nil, err
+	}
+	values, err := chartSpecValues(req.GetChart())
+	if err != nil {
+		return nil, err
+	}
+	proposed, err := upgrade.RunWithContext(ctx, req.GetReleaseName(), chart, values)
+	if err != nil {
+		logger.L().Error("Failed to render upgrade", zap.Error(err))
+		return &pb.DiffReleaseResponse{
+			Code:            1,
+			Message:         fmt.Sprintf("Failed to render upgrade: %v", err),
+			Success:         false,
+			CurrentRevision: int32(current.Version),
+		}, nil
+	}
+
+	masker, err := newSecretMasker()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "initialize secret masking failed: %v", err)
+	}
+	var live liveObjectFunc
+	if req.GetCompareLive() {
+		live = func(res *manifestResource) (map[string]any, error) {
+			return liveObject(actionConfig, res)
+		}
+	}
+	resp, err := diffManifests(current.Manifest, proposed.Manifest, masker, live, contextLines)
+	if err != nil {
+		return nil, err
+	}
+	resp.CurrentRevision = int32(current.Version)
+	resp.CurrentChart = current.Chart.Metadata.Name + "-" + current.Chart.Metadata.Version
+	resp.TargetChart = chart.Metadata.Name + "-" + chart.Metadata.Version
+	logger.L().Info("Release diff generated", zap.String("release", req.GetReleaseName()), zap.String("summary", resp.Message))
+	return resp, nil
+}
+
+// liveObjectFunc 返回资源在集群中的实际内容，资源不存在时返回 nil
+type liveObjectFunc func(res *manifestResource) (map[string]any, error)
+
+// diffManifests 逐个资源对比两个 release manifest，live 不为空时同时对比集群中的实际资源。
+// hook 不参与升级时的资源对比，只对比 release manifest
+func diffManifests(currentManifest, proposedManifest string, masker *secretMasker, live liveObjectFunc, contextLines int) (*pb.DiffReleaseResponse, error) {
+	oldResources, err := parseManifestResources(currentManifest, masker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "parse current manifest failed: %v", err)
+	}
+	newResources, err := parseManifestResources(proposedManifest, masker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "parse proposed manifest failed: %v", err)
+	}
+
+	keys := make([]string, 0, len(oldResources)+len(newResources))
+	for k := range oldResources {
+		keys = append(keys, k)
+	}
+	for k := range newResources {
+		if _, ok := oldResources[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	resp := &pb.DiffReleaseResponse{
+		Code:    0,
+		Success: true,
+	}
+	counts := map[string]int{}
+	for _, key := range keys {
+		oldRes, newRes := oldResources[key], newResources[key]
+		ref := newRes
+		if ref == nil {
+			ref = oldRes
+		}
+		diff := &pb.ResourceDiff{
+			ApiVersion: ref.obj.GetAPIVersion(),
+			Kind:       ref.obj.GetKind(),
+			Name:       ref.obj.GetName(),
+			Namespace:  ref.obj.GetNamespace(),
+		}
+
+		var oldDoc, newDoc string
+		switch {
+		case oldRes == nil:
+			diff.Change = changeAdded
+			newDoc = newRes.doc
+		case newRes == nil:
+			diff.Change = changeRemoved
+			oldDoc = oldRes.doc
+		default:
+			oldDoc, newDoc = oldRes.doc, newRes.doc
+			diff.Change = changeUnchanged
+			if oldDoc != newDoc {
+				diff.Change = changeChanged
+				diff.ImmutableFields = changedImmutableFields(oldRes.obj, newRes.obj)
+				diff.RequiresRecreate = len(diff.ImmutableFields) > 0
+			}
+		}
+		counts[diff.Change]++
+		if diff.Change != changeUnchanged {
+			diff.Diff, err = unifiedDiff(oldDoc, newDoc, "current/"+key, "proposed/"+key, contextLines)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "diff %s failed: %v", key, err)
+			}
+		}
+
+		if live != nil && newRes != nil {
+			diff.LiveDiff, err = liveDiff(live, masker, newRes, key, contextLines)
+			if err != nil {
+				logger.L().Warn("Failed to compare with live object", zap.String("resource", key), zap.Error(err))
+				diff.LiveDiff = fmt.Sprintf("# failed to get live object: %v\n", err)
+			}
+		}
+		resp.Resources = append(resp.Resources, diff)
+	}
+
+	resp.Message = fmt.Sprintf("%d added, %d removed, %d changed, %d unchanged",
+		counts[changeAdded], counts[changeRemoved], counts[changeChanged], counts[changeUnchanged])
+	return resp, nil
+}
+
+// secretMasker 替换 Secret 中 data、stringData 的值，查看者只能看到哪些键发生了变化。
+// 值替换为使用随机密钥的 HMAC 摘要：同一次对比中相同的值得到相同的摘要，
+// 摘要无法跨请求比对，也无法用字典反推出弱口令
+type secretMasker struct {
+	key []byte
+}
+
+func newSecretMasker() (*secretMasker, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &secretMasker{key: key}, nil
+}
+
+// mask 返回替换了 Secret 值的副本，kind 不是 Secret 时原样返回 content
+func (m *secretMasker) mask(kind string, content map[string]any) map[string]any {
+	if kind != "Secret" {
+		return content
+	}
+	masked := make(map[string]any, len(content))
+	for k, v := range content {
+		masked[k] = v
+	}
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := content[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		out := make(map[string]any, len(values))
+		for k, v := range values {
+			mac := hmac.New(sha256.New, m.key)
+			fmt.Fprint(mac, v)
+			out[k] = fmt.Sprintf("(redacted %x)", mac.Sum(nil)[:8])
+		}
+		masked[field] = out
+	}
+	return masked
+}
+
+// parseManifestResources 解析 release manifest，按 apiVersion/kind/namespace/name 索引。
+// 用于展示的 doc 中 Secret 的值由 masker 替换
+func parseManifestResources(manifest string, masker *secretMasker) (map[string]*manifestResource, error) {
+	resources := map[string]*manifestResource{}
+	for _, doc := range splitManifests(manifest) {
+		var content map[string]any
+		if err := yaml.Unmarshal([]byte(doc), &content); err != nil {
+			return nil, fmt.Errorf("invalid yaml in %s: %w", manifestSource(doc), err)
+		}
+		if len(content) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: content}
+		normalized, err := yaml.Marshal(masker.mask(obj.GetKind(), content))
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s/%s/%s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		resources[key] = &manifestResource{obj: obj, doc: string(normalized), yaml: doc}
+	}
+	return resources, nil
+}
+
+func unifiedDiff(a, b, fromFile, toFile string, contextLines int) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  contextLines,
+	})
+}
+
+// changedImmutableFields 返回新旧资源之间发生变化的不可变字段
+func changedImmutableFields(oldObj, newObj *unstructured.Unstructured) []string {
+	var changed []string
+	if oldObj.GetKind() != newObj.GetKind() {
+		return nil
+	}
+	for _, path := range immutableFields[oldObj.GetKind()] {
+		oldVal, oldFound, _ := unstructured.NestedFieldNoCopy(oldObj.Object, path...)
+		newVal, newFound, _ := unstructured.NestedFieldNoCopy(newObj.Object, path...)
+		// clusterIP 等字段未填写时由集群分配，只有两边都显式设置时才算修改
+		if oldObj.GetKind() == "Service" && (!oldFound || !newFound) {
+			continue
+		}
+		if oldFound != newFound || !reflect.DeepEqual(oldVal, newVal) {
+			changed = append(changed, strings.Join(path, "."))
+		}
+	}
+	// immutable 的 ConfigMap/Secret 不能修改数据
+	if immutable, _, _ := unstructured.NestedBool(oldObj.Object, "immutable"); immutable {
+		for _, field := range []string{"data", "binaryData", "stringData"} {
+			if !reflect.DeepEqual(oldObj.Object[field], newObj.Object[field]) {
+				changed = append(changed, field)
+			}
+		}
+	}
+	return changed
+}
+
+// liveDiff 对比集群中的实际资源与升级后的资源。实际资源只保留 manifest 中出现的字段，
+// 避免状态、默认值等集群填充的字段淹没真正的差异
+func liveDiff(live liveObjectFunc, masker *secretMasker, res *manifestResource, key string, contextLines int) (string, error) {
+	obj, err := live(res)
+	if err != nil {
+		return "", err
+	}
+	var liveDoc string
+	if obj != nil {
+		pruned, _ := pruneToShape(obj, res.obj.Object).(map[string]any)
+		data, err := yaml.Marshal(masker.mask(res.obj.GetKind(), pruned))
+		if err != nil {
+			return "", err
+		}
+		liveDoc = string(data)
+	}
+	return unifiedDiff(liveDoc, res.doc, "live/"+key, "proposed/"+key, contextLines)
+}
+
+// liveObject 查询 manifest 资源在集群中的实际内容
+func liveObject(actionConfig *action.Configuration, res *manifestResource) (map[string]any, error) {
+	resources, err := actionConfig.KubeClient.Build(strings.NewReader(res.yaml), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("resource %s/%s not recognized by cluster", res.obj.GetKind(), res.obj.GetName())
+	}
+	info := resources[0]
+	if err := info.Get(); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+}
+
+// pruneToShape 只保留 live 中在 shape 里出现过的字段，列表按下标逐个裁剪
+func pruneToShape(live, shape any) any {
+	switch s := shape.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		out := make(map[string]any, len(s))
+		for k, v := range s {
+			if lv, ok := l[k]; ok {
+				out[k] = pruneToShape(lv, v)
+			}
+		}
+		return out
+	case []any:
+		l, ok := live.([]any)
+		if !ok {
+			return live
+		}
+		out := make([]any, len(l))
+		for i := range l {
+			if i < len(s) {
+				out[i] = pruneToShape(l[i], s[i])
+			} else {
+				out[i] = l[i]
+			}
+		}
+		return out
+	default:
+		return live
+	}
+}
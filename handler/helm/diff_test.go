@@ -0,0 +1,121 @@
+package helm
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const diffTestCurrentManifest = `---
+# Source: demo/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-credentials
+stringData:
+  password: old-s3cret-value
+data:
+  token: b2xkLXRva2VuLXZhbHVl
+  unchanged: c2FtZS12YWx1ZS1rZXB0
+---
+# Source: demo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  mode: production
+`
+
+const diffTestProposedManifest = `---
+# Source: demo/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: demo-credentials
+stringData:
+  password: new-s3cret-value
+data:
+  token: bmV3LXRva2VuLXZhbHVl
+  unchanged: c2FtZS12YWx1ZS1rZXB0
+---
+# Source: demo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: demo-config
+data:
+  mode: staging
+`
+
+func TestDiffManifestsRedactsSecretValues(t *testing.T) {
+	masker, err := newSecretMasker()
+	if err != nil {
+		t.Fatalf("newSecretMasker: %v", err)
+	}
+	// 集群中的 Secret 只有 data，值为 base64
+	live := func(res *manifestResource) (map[string]any, error) {
+		if res.obj.GetKind() != "Secret" {
+			return res.obj.Object, nil
+		}
+		return map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "demo-credentials", "resourceVersion": "42"},
+			"data": map[string]any{
+				"password":  base64.StdEncoding.EncodeToString([]byte("live-s3cret-value")),
+				"token":     "bGl2ZS10b2tlbi12YWx1ZQ==",
+				"unchanged": "c2FtZS12YWx1ZS1rZXB0",
+			},
+		}, nil
+	}
+
+	resp, err := diffManifests(diffTestCurrentManifest, diffTestProposedManifest, masker, live, defaultDiffContext)
+	if err != nil {
+		t.Fatalf("diffManifests() error = %v", err)
+	}
+	out, err := protojson.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	for _, secret := range []string{
+		"old-s3cret-value", "new-s3cret-value", "live-s3cret-value",
+		"b2xkLXRva2VuLXZhbHVl", "bmV3LXRva2VuLXZhbHVl", "bGl2ZS10b2tlbi12YWx1ZQ==",
+		"c2FtZS12YWx1ZS1rZXB0", base64.StdEncoding.EncodeToString([]byte("live-s3cret-value")),
+	} {
+		if strings.Contains(string(out), secret) {
+			t.Errorf("response contains secret value %q:\n%s", secret, out)
+		}
+	}
+
+	if len(resp.Resources) != 2 {
+		t.Fatalf("resources = %d, want 2", len(resp.Resources))
+	}
+	for _, diff := range resp.Resources {
+		if diff.Change != changeChanged {
+			t.Errorf("%s/%s change = %s, want changed", diff.Kind, diff.Name, diff.Change)
+		}
+		switch diff.Kind {
+		case "Secret":
+			if !strings.Contains(diff.LiveDiff, "-  token: (redacted ") {
+				t.Errorf("secret live diff does not show the changed key:\n%s", diff.LiveDiff)
+			}
+			// 变化的键仍能在 diff 中看出，未变化的键不出现在变更行中
+			for _, line := range strings.Split(diff.Diff, "\n") {
+				changed := strings.HasPrefix(line, "-  ") || strings.HasPrefix(line, "+  ")
+				if changed && strings.Contains(line, "unchanged:") {
+					t.Errorf("unchanged key reported as changed: %q", line)
+				}
+			}
+			if !strings.Contains(diff.Diff, "+  password: (redacted ") || !strings.Contains(diff.Diff, "+  token: (redacted ") {
+				t.Errorf("secret diff does not show changed keys:\n%s", diff.Diff)
+			}
+		case "ConfigMap":
+			if !strings.Contains(diff.Diff, "+  mode: staging") {
+				t.Errorf("configmap values must not be redacted:\n%s", diff.Diff)
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/kubectl/pkg/validation"
+	"sigs.k8s.io/yaml"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// dryRunInstall 在服务端渲染 chart（可以使用 lookup 访问集群），逐个资源按集群的 OpenAPI schema 校验，
+// 不会创建任何资源。渲染出的资源按 Kind 分组返回，校验失败的资源记录在 ValidationErrors 中
+func dryRunInstall(ctx context.Context, req *pb.InstallChartRequest, values map[string]any) (*pb.InstallChartResponse, error) {
+	actionConfig, err := newActionConfig(req.GetNamespace())
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "init helm action config failed: %v", err)
+	}
+
+	chartRef, registryClient, err := resolveChartRef(req.GetRepoName(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if registryClient != nil {
+		actionConfig.RegistryClient = registryClient
+	}
+
+	install := action.NewInstall(actionConfig)
+	install.ReleaseName = req.GetReleaseName()
+	install.Namespace = req.GetNamespace()
+	install.Version = req.GetVersion()
+	install.ChartPathOptions.InsecureSkipTLSverify = true
+	install.DryRun = true
+	install.DryRunOption = "server"
+	// 整体校验遇到第一个错误就会返回，这里关闭后逐个资源校验
+	install.DisableOpenAPIValidation = true
+
+	chartPath, err := install.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
+	if err != nil {
+		logger.L().Error("Failed to locate chart", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "locate chart failed: %v", err)
+	}
+	chart, err := loadChart(ctx, chartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := install.RunWithContext(ctx, chart, values)
+	if err != nil {
+		// 模板渲染或 values schema 校验失败
+		logger.L().Error("Failed to render chart", zap.Error(err))
+		return &pb.InstallChartResponse{
+			Code:        1,
+			ReleaseName: req.GetReleaseName(),
+			Message:     fmt.Sprintf("Failed to render chart: %v", err),
+		}, nil
+	}
+
+	manifests := []string{rel.Manifest}
+	for _, hook := range rel.Hooks {
+		manifests = append(manifests, fmt.Sprintf("# Source: %s\n%s", hook.Path, hook.Manifest))
+	}
+
+	resp := &pb.InstallChartResponse{
+		Code:        0,
+		ReleaseName: rel.Name,
+		Status:      rel.Info.Status.String(),
+		Notes:       rel.Info.Notes,
+		Entries:     map[string]*pb.K8SObjectList{},
+	}
+	validator, err := newManifestValidator(actionConfig)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create manifest validator failed: %v", err)
+	}
+	for _, manifest := range manifests {
+		for _, doc := range splitManifests(manifest) {
+			obj, validationErr := validateManifest(actionConfig, validator, doc, req.GetNamespace())
+			if validationErr != nil {
+				resp.ValidationErrors = append(resp.ValidationErrors, validationErr)
+			}
+			if obj == nil {
+				continue
+			}
+			item, err := toK8sObject(obj)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "marshal %s %s failed: %v", obj.GetKind(), obj.GetName(), err)
+			}
+			list, ok := resp.Entries[obj.GetKind()]
+			if !ok {
+				list = &pb.K8SObjectList{}
+				resp.Entries[obj.GetKind()] = list
+			}
+			list.Items = append(list.Items, item)
+		}
+	}
+
+	if len(resp.ValidationErrors) > 0 {
+		resp.Code = 1
+		resp.Message = fmt.Sprintf("Dry run found %d invalid resources", len(resp.ValidationErrors))
+	} else {
+		resp.Message = "Dry run succeeded"
+	}
+	logger.L().Info("Dry run finished", zap.String("release", rel.Name), zap.Int("invalid", len(resp.ValidationErrors)))
+	return resp, nil
+}
+
+// splitManifests 按渲染顺序拆分多文档 manifest
+func splitManifests(manifest string) []string {
+	split := releaseutil.SplitManifests(manifest)
+	keys := make([]string, 0, len(split))
+	for k := range split {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+	docs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		docs = append(docs, split[k])
+	}
+	return docs
+}
+
+// newManifestValidator 创建按集群 OpenAPI schema 做客户端校验的 validator。
+// KubeClient.Build 在服务端支持 fieldValidation 时会跳过客户端校验，交给创建请求处理，
+// dry run 不会真正创建资源，所以这里直接使用 schema 校验
+func newManifestValidator(actionConfig *action.Configuration) (validation.Schema, error) {
+	kubeClient, ok := actionConfig.KubeClient.(*kube.Client)
+	if !ok {
+		return nil, fmt.Errorf("unexpected kube client %T", actionConfig.KubeClient)
+	}
+	getter, ok := kubeClient.Factory.(openapi.OpenAPIResourcesGetter)
+	if !ok {
+		return nil, fmt.Errorf("kube client factory %T does not provide OpenAPI schema", kubeClient.Factory)
+	}
+	return validation.ConjunctiveSchema{
+		validation.NewSchemaValidation(getter),
+		validation.NoDoubleKeySchema{},
+	}, nil
+}
+
+// validateManifest 解析单个资源并按集群 OpenAPI schema 校验。
+// 无法解析时返回的对象为 nil，只有校验错误
+func validateManifest(actionConfig *action.Configuration, validator validation.Schema, doc, namespace string) (*unstructured.Unstructured, *pb.ResourceValidationError) {
+	source := manifestSource(doc)
+	var content map[string]any
+	if err := yaml.Unmarshal([]byte(doc), &content); err != nil {
+		return nil, &pb.ResourceValidationError{Source: source, Error: fmt.Sprintf("invalid yaml: %v", err)}
+	}
+	if len(content) == 0 {
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{Object: content}
+	ns := obj.GetNamespace()
+	if ns == "" {
+		ns = namespace
+	}
+
+	// Build 确认集群能识别该资源类型（如 CRD 是否已安装）
+	_, err := actionConfig.KubeClient.Build(strings.NewReader(doc), false)
+	if err == nil {
+		err = validator.ValidateBytes([]byte(doc))
+	}
+	if err != nil {
+		return obj, &pb.ResourceValidationError{
+			Kind:      obj.GetKind(),
+			Name:      obj.GetName(),
+			Namespace: ns,
+			Source:    source,
+			Error:     err.Error(),
+		}
+	}
+	return obj, nil
+}
+
+// manifestSource 读取 helm 在每个资源前写入的 "# Source: <template>" 注释
+func manifestSource(doc string) string {
+	for _, line := range strings.Split(doc, "\n") {
+		if src, ok := strings.CutPrefix(strings.TrimSpace(line), "# Source:"); ok {
+			return strings.TrimSpace(src)
+		}
+	}
+	return ""
+}
+
+// toK8sObject 把资源转换为 google.protobuf.Struct 后包装到 Any 中
+func toK8sObject(obj *unstructured.Unstructured) (*pb.K8SObject, error) {
+	st, err := structpb.NewStruct(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	anyObj, err := anypb.New(st)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.K8SObject{Object: anyObj}, nil
+}
@@ -1,15 +1,11 @@
 package helm
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
-	"io"
+	"errors"
 	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/operation"
 	"log"
-	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
@@ -17,15 +13,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
-	"gopkg.in/yaml.v2"
-	"helm.sh/helm/v3/pkg/chart/loader"
-	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/chart"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -37,12 +32,15 @@ import (
 	"go.uber.org/zap"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/cli"
-	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 const (
 	defaultRepositoryConfigPath = "/opt/helm/repositories.yaml"
+	defaultRollbackTimeout      = 5 * time.Minute
+	defaultUninstallTimeout     = 5 * time.Minute
 )
 
 // 默认配置
@@ -89,7 +87,7 @@ func init() {
 			log.Fatal("Failed to load repository config:", err)
 		}
 		if e := repoEntry.Get("harbor"); e != nil {
-			harborEntry = *e
+			setHarborEntry(*e)
 		}
 
 	} else {
@@ -151,9 +149,10 @@ func createRespositoryConfig(s *cli.EnvSettings) error {
 		return err
 	}
 
-	if !repoFile.Has(harborEntry.Name) {
-		logger.L().Info("Adding new repository", zap.String("name", harborEntry.Name), zap.String("url", harborEntry.URL))
-		repoFile.Add(&harborEntry)
+	harbor := defaultHarborEntry()
+	if !repoFile.Has(harbor.Name) {
+		logger.L().Info("Adding new repository", zap.String("name", harbor.Name), zap.String("url", harbor.URL))
+		repoFile.Add(&harbor)
 	}
 
 	if err := repoFile.WriteFile(s.RepositoryConfig, 0644); err != nil {
@@ -176,33 +175,50 @@ func fileExists(path string) bool {
 	return false // 其他错误（如权限问题）
 }
 
-// 实现 ListCharts 方法
-func (s *HelmManagerServer) ListCharts(ctx context.Context, req *pb.ListChartsRequest) (*pb.ListChartsResponse, error) {
-	logger.L().Info("ListCharts called", zap.String("request", req.String()))
-	providers := getter.All(cli.New())
+// newActionConfig 为指定 namespace 创建专用的 action configuration
+func newActionConfig(namespace string) (*action.Configuration, error) {
+	actionConfig := new(action.Configuration)
+	debugLog := func(format string, v ...interface{}) {
+		logger.L().Debug(fmt.Sprintf(format, v...))
+	}
+	if err := actionConfig.Init(helmClient.settings.RESTClientGetter(), namespace, "secret", debugLog); err != nil {
+		return nil, err
+	}
+	return actionConfig, nil
+}
 
-	chartRepo, err := repo.NewChartRepository(&harborEntry, providers)
+// kubeRestConfig 优先使用集群内配置，失败时回退到本地 kubeconfig
+func kubeRestConfig() (*rest.Config, error) {
+	config, err := rest.InClusterConfig()
 	if err != nil {
-		logger.L().Error("Failed to create new chart repository", zap.Error(err))
-		return nil, err
+		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create k8s config: %w", err)
+		}
 	}
+	return config, nil
+}
 
-	// 打印索引内容
-	indexPath, err := chartRepo.DownloadIndexFile()
+func kubeClientset() (*kubernetes.Clientset, error) {
+	config, err := kubeRestConfig()
 	if err != nil {
-		logger.L().Error("Failed to download index file from chart repository", zap.Error(err))
 		return nil, err
-	} else {
-		logger.L().Info("Index file downloaded successfully", zap.String("repository", harborEntry.Name))
 	}
+	return kubernetes.NewForConfig(config)
+}
 
-	// 解析索引文件
-	indexFile, err := repo.LoadIndexFile(indexPath)
+func kubeDynamicClient() (dynamic.Interface, error) {
+	config, err := kubeRestConfig()
 	if err != nil {
-		logger.L().Error("Failed to load index file", zap.Error(err))
 		return nil, err
 	}
+	return dynamic.NewForConfig(config)
+}
 
+// 实现 ListCharts 方法
+func (s *HelmManagerServer) ListCharts(ctx context.Context, req *pb.ListChartsRequest) (*pb.ListChartsResponse, error) {
+	logger.L().Info("ListCharts called", zap.String("request", req.String()))
 	if req.Limit <= 0 || req.Size <= 0 {
 		return &pb.ListChartsResponse{
 			Code:    1,
@@ -211,63 +227,85 @@ func (s *HelmManagerServer) ListCharts(ctx context.Context, req *pb.ListChartsRe
 			Data:    nil,
 		}, nil
 	}
+	sortBy := req.GetSortBy()
+	if sortBy != "" && sortBy != chartSortByName && sortBy != chartSortByUpdated {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort_by %q, must be %q or %q", sortBy, chartSortByName, chartSortByUpdated)
+	}
 
-	var chartInfos []*pb.ChartInfo
+	entry, err := getRepoEntry(req.GetRepoName())
+	if err != nil {
+		return nil, err
+	}
+	if registry.IsOCI(entry.URL) {
+		return nil, status.Errorf(codes.FailedPrecondition, "repository %q is an OCI registry, use ListChartTags instead", entry.Name)
+	}
 
-	// 通过 indexFile 获取所有的 chart 信息
-	for _, entries := range indexFile.Entries {
-		for _, entry := range entries {
-			chartInfo := &pb.ChartInfo{
-				Name:         entry.Name,
-				ChartVersion: entry.Version,
-				IconUrl:      entry.Icon,
-				AppVersion:   entry.AppVersion,
-				Description:  entry.Description,
-				UpdateDate:   entry.Created.String(),
-				UpdateUser:   "admin", // 这里可以根据实际情况修改
-			}
-			chartInfos = append(chartInfos, chartInfo)
+	indexFile, err := repoIndexes.Get(entry)
+	if err != nil {
+		logger.L().Error("Failed to load repository index", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "load repository index failed: %v", err)
+	}
+
+	// 同一个 chart 的所有版本合并为一条，展示最新的稳定版本；关键词在分页前过滤
+	keyword := strings.ToLower(strings.TrimSpace(req.Keyword))
+	var charts []*repo.ChartVersion
+	versions := map[string][]string{}
+	for name, entries := range indexFile.Entries {
+		if len(entries) == 0 {
+			continue
+		}
+		latest := latestChartVersion(entries)
+		if keyword != "" && !chartMatchesKeyword(latest, keyword) {
+			continue
+		}
+		charts = append(charts, latest)
+		for _, v := range entries {
+			versions[name] = append(versions[name], v.Version)
 		}
 	}
 
-	// 按照 chartInfos.Name 首写字母排序
-	sort.Slice(chartInfos, func(i, j int) bool {
-		return strings.ToLower(chartInfos[i].Name)[0] < strings.ToLower(chartInfos[j].Name)[0]
+	sort.Slice(charts, func(i, j int) bool {
+		if sortBy == chartSortByUpdated && !charts[i].Created.Equal(charts[j].Created) {
+			return charts[i].Created.After(charts[j].Created)
+		}
+		return strings.ToLower(charts[i].Name) < strings.ToLower(charts[j].Name)
 	})
 
-	totalCharts := len(chartInfos)
+	totalCharts := len(charts)
 	pageCount := int32(totalCharts) / req.Size
 	if int32(totalCharts)%req.Size != 0 {
 		pageCount++
 	}
-	start := (req.Limit - 1) * req.Size
-	end := req.Limit * req.Size
-	if int(start) > totalCharts {
-		start = int32(totalCharts)
+	start := int(req.Limit-1) * int(req.Size)
+	end := int(req.Limit) * int(req.Size)
+	if start > totalCharts {
+		start = totalCharts
 	}
-	if int(end) > totalCharts {
-		end = int32(totalCharts)
+	if end > totalCharts {
+		end = totalCharts
+	}
+
+	chartInfos := make([]*pb.ChartInfo, 0, end-start)
+	for _, chart := range charts[start:end] {
+		chartInfos = append(chartInfos, &pb.ChartInfo{
+			Name:         chart.Name,
+			ChartVersion: chart.Version,
+			IconUrl:      chart.Icon,
+			AppVersion:   chart.AppVersion,
+			Description:  chart.Description,
+			UpdateDate:   chart.Created.String(),
+			UpdateUser:   "admin", // 这里可以根据实际情况修改
+			Versions:     versions[chart.Name],
+			Keywords:     chart.Keywords,
+		})
 	}
-	pagedCharts := chartInfos[int(start):int(end)]
 
 	listChartsData := &pb.ListChartsData{
 		Total:       int32(totalCharts),
 		PageSize:    req.Size,
 		TotalPage:   pageCount,
 		CurrentPage: req.Limit,
-		Charts:      pagedCharts,
-	}
-
-	if req.Keyword != "" {
-		// 过滤包含关键词的 charts
-		filteredCharts := make([]*pb.ChartInfo, 0)
-		for _, chart := range pagedCharts {
-			if strings.Contains(strings.ToLower(chart.Name), strings.ToLower(req.Keyword)) {
-				filteredCharts = append(filteredCharts, chart)
-			}
-		}
-		listChartsData.Charts = filteredCharts
-		listChartsData.Total = int32(len(filteredCharts))
+		Charts:      chartInfos,
 	}
 
 	anyData, err := anypb.New(listChartsData)
@@ -289,418 +327,361 @@ func (s *HelmManagerServer) ListCharts(ctx context.Context, req *pb.ListChartsRe
 	}, nil
 }
 
+// ListCharts 支持的排序字段
+const (
+	chartSortByName    = "name"
+	chartSortByUpdated = "updated"
+)
+
+// latestChartVersion 返回最新的稳定版本，没有稳定版本时返回最新版本。
+// 索引加载时已按版本从高到低排序
+func latestChartVersion(entries repo.ChartVersions) *repo.ChartVersion {
+	for _, v := range entries {
+		if sv, err := semver.NewVersion(v.Version); err == nil && sv.Prerelease() == "" {
+			return v
+		}
+	}
+	return entries[0]
+}
+
+// chartMatchesKeyword 在名称、描述和关键字中查找关键词，keyword 需为小写
+func chartMatchesKeyword(chart *repo.ChartVersion, keyword string) bool {
+	if strings.Contains(strings.ToLower(chart.Name), keyword) ||
+		strings.Contains(strings.ToLower(chart.Description), keyword) {
+		return true
+	}
+	for _, k := range chart.Keywords {
+		if strings.Contains(strings.ToLower(k), keyword) {
+			return true
+		}
+	}
+	return false
+}
+
 // 安装完应用之后，需要将用户，和应用写入数据库，进行维护
 
 // 实现按照helm chart方法
 func (s *HelmManagerServer) InstallChart(ctx context.Context, req *pb.InstallChartRequest) (*pb.InstallChartResponse, error) {
 	logger.L().Info("InstallChart called", zap.String("request", req.String()))
-	namespace := req.GetNamespace()
+	// 按工作空间安装时使用对应的托管命名空间，dry run 不创建命名空间
+	namespace, err := scopedNamespace(ctx, req.GetNamespace(), req.GetScope(), !req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
 	if namespace == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "namespace is required")
 	}
-	// 1. 获取参数
-	dryRun := req.DryRun
-	// 2. 创建 Helm action 配置
+	req.Namespace = namespace
+	if !registry.IsOCI(req.GetName()) {
+		if _, err := getRepoEntry(req.GetRepoName()); err != nil {
+			return nil, err
+		}
+	}
 
-	// 3. 解析 values
-	var values map[string]any
-	if dryRun {
-		var message string
+	// dry run 同步渲染并校验，不创建异步操作
+	if req.DryRun {
+		var values map[string]any
 		if req.Values != "" {
 			if err := unmarshalValues(req.Values, &values); err != nil {
 				logger.L().Error("Failed to parse values", zap.Error(err))
-				return nil, err
+				return nil, status.Errorf(codes.InvalidArgument, "invalid values: %v", err)
 			}
 		}
-		return &pb.InstallChartResponse{
-			Code:    0,
-			Message: message,
-		}, nil
-	} else {
-		// 4. 安装 chart
-		releaseName := req.GetReleaseName()
-		// 为指定 namespace 创建专用的 action configuration
-		actionConfig := new(action.Configuration)
-		debugLog := func(format string, v ...interface{}) {
-			logger.L().Debug(fmt.Sprintf(format, v...))
-		}
-		if err := actionConfig.Init(helmClient.settings.RESTClientGetter(), namespace, "secret", debugLog); err != nil {
-			logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
-			return nil, err
-		}
-
-		// 创建 Install action，使用专用的 actionConfig
-		install := action.NewInstall(actionConfig)
-		install.ReleaseName = releaseName
-		install.Namespace = namespace
-		if req.Version != "" {
-			install.Version = req.Version
-		}
-		install.ChartPathOptions.InsecureSkipTLSverify = true
-		install.CreateNamespace = true // 确保 namespace 存在，如果不存在则创建
+		return dryRunInstall(ctx, req, values)
+	}
 
-		err := refreshChartRepository()
-		if err != nil {
-			logger.L().Error("Failed to refresh chart repository", zap.Error(err))
-			return nil, err
-		}
+	// 提交异步安装操作
+	op, err := submitOperation(ctx, operation.TypeInstall, namespace, req.GetReleaseName(), req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InstallChartResponse{
+		Code:        0,
+		ReleaseName: req.GetReleaseName(),
+		Message:     "Install operation accepted",
+		Status:      op.Status,
+		OperationId: op.ID,
+	}, nil
+}
 
-		chartRef := fmt.Sprintf("%s/%s", harborEntry.Name, req.Name)
+// runInstall 在操作 worker 中执行实际的安装
+func runInstall(ctx context.Context, req *pb.InstallChartRequest) (*pb.InstallChartResponse, error) {
+	namespace := req.GetNamespace()
+	releaseName := req.GetReleaseName()
 
-		chartPath, err := install.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
-		if err != nil {
-			logger.L().Error("Failed to locate chart", zap.Error(err))
+	var values map[string]any
+	if req.Values != "" {
+		if err := unmarshalValues(req.Values, &values); err != nil {
+			logger.L().Error("Failed to parse values", zap.Error(err))
 			return nil, err
 		}
+	}
 
-		chart, err := loader.Load(chartPath)
-		if err != nil {
-			logger.L().Error("Failed to load chart", zap.Error(err))
-			return nil, err
-		}
+	// 为指定 namespace 创建专用的 action configuration
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
+		return nil, err
+	}
 
-		var release *release.Release
-		get := action.NewGet(actionConfig)
-
-		if _, err := get.Run(releaseName); err == nil {
-			// Release 已经存在则退出安装
-			logger.L().Info("Release already exists, skipping installation", zap.String("release_name", releaseName))
-			return &pb.InstallChartResponse{
-				Code:        0,
-				Message:     "Release already exists, skipping installation",
-				ReleaseName: releaseName,
-			}, nil
-		} else {
-			// Release 不存在，执行安装
-			release, err = install.Run(chart, values)
-			if err != nil {
-				logger.L().Error("Failed to install chart", zap.Error(err))
-				// 安装失败，调用uninstall方法清理已安装的资源
-				uninstall := action.NewUninstall(actionConfig)
-				uninstall.Wait = true
-				uninstall.Run(releaseName)
-				return &pb.InstallChartResponse{
-					Code:        1,
-					Message:     fmt.Sprintf("Failed to install chart: %v", err),
-					ReleaseName: releaseName,
-				}, err
-			}
+	// 创建 Install action，使用专用的 actionConfig
+	install := action.NewInstall(actionConfig)
+	install.ReleaseName = releaseName
+	install.Namespace = namespace
+	if req.Version != "" {
+		install.Version = req.Version
+	}
+	install.ChartPathOptions.InsecureSkipTLSverify = true
+	install.CreateNamespace = true // 确保 namespace 存在，如果不存在则创建
 
-			logger.L().Info("Chart installed successfully", zap.String("release", release.Name))
-		}
+	operation.Report(ctx, "Resolving chart "+req.Name)
+	chartRef, registryClient, err := resolveChartRef(req.GetRepoName(), req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if registryClient != nil {
+		actionConfig.RegistryClient = registryClient
+		install.SetRegistryClient(registryClient)
+	}
 
-		// 获取release info 中的 k8s 资源信息
-		// parseAndPrintManifest(release.Manifest)
-		// 调用成功之后，更新jos_user_app 表
-		// userApp := &model.JosUserApp{
-		// 	AppName: req.ReleaseName,
-		// }
-		// if err := db.DB.PutJosUserApp(userApp); err != nil {
-		// 	logger.L().Error("Failed to update jos_user_app", zap.Error(err))
-		// }
+	operation.Report(ctx, "Locating chart "+chartRef)
+	chartPath, err := install.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
+	if err != nil {
+		logger.L().Error("Failed to locate chart", zap.Error(err))
+		return nil, err
+	}
+
+	chart, err := loadChart(ctx, chartPath)
+	if err != nil {
+		return nil, err
+	}
 
+	get := action.NewGet(actionConfig)
+	if _, err := get.Run(releaseName); err == nil {
+		// Release 已经存在则退出安装
+		logger.L().Info("Release already exists, skipping installation", zap.String("release_name", releaseName))
 		return &pb.InstallChartResponse{
-			Code:          0,
-			ReleaseName:   release.Name,
-			FirstDeployed: release.Info.FirstDeployed.String(),
-			LastDeployed:  release.Info.LastDeployed.String(),
-			Deleted:       release.Info.Deleted.String(),
-			Message:       release.Info.Description,
-			Status:        release.Info.Status.String(),
+			Code:        0,
+			Message:     "Release already exists, skipping installation",
+			ReleaseName: releaseName,
 		}, nil
 	}
-}
 
-// unmarshalValues tries to unmarshal a YAML or JSON string into a map[string]interface{}.
-func unmarshalValues(data string, out *map[string]interface{}) error {
-	// Try YAML first
-	if err := yaml.Unmarshal([]byte(data), out); err == nil {
-		return nil
+	// Release 不存在，执行安装
+	operation.Report(ctx, "Installing release "+releaseName)
+	release, err := install.RunWithContext(ctx, chart, values)
+	if err != nil {
+		logger.L().Error("Failed to install chart", zap.Error(err))
+		// 安装失败，调用uninstall方法清理已安装的资源
+		uninstall := action.NewUninstall(actionConfig)
+		uninstall.Wait = true
+		uninstall.Run(releaseName)
+		return &pb.InstallChartResponse{
+			Code:        1,
+			Message:     fmt.Sprintf("Failed to install chart: %v", err),
+			ReleaseName: releaseName,
+		}, err
 	}
-	// Try JSON
-	return json.Unmarshal([]byte(data), out)
+
+	logger.L().Info("Chart installed successfully", zap.String("release", release.Name))
+
+	// 调用成功之后，更新jos_user_app 表
+	// userApp := &model.JosUserApp{
+	// 	AppName: req.ReleaseName,
+	// }
+	// if err := db.DB.PutJosUserApp(userApp); err != nil {
+	// 	logger.L().Error("Failed to update jos_user_app", zap.Error(err))
+	// }
+
+	return &pb.InstallChartResponse{
+		Code:          0,
+		ReleaseName:   release.Name,
+		FirstDeployed: release.Info.FirstDeployed.String(),
+		LastDeployed:  release.Info.LastDeployed.String(),
+		Deleted:       release.Info.Deleted.String(),
+		Message:       release.Info.Description,
+		Status:        release.Info.Status.String(),
+	}, nil
 }
 
 func (s *HelmManagerServer) UninstallChart(ctx context.Context, req *pb.UninstallChartRequest) (*pb.UninstallChartResponse, error) {
 	logger.L().Info("UninstallChart called", zap.String("request", req.String()))
-	nameSpace := req.GetNamespace()
-	if nameSpace == "" {
+	if req.GetNamespace() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "namespace is required")
 	}
-
-	// 为指定 namespace 创建新的 action configuration
-	actionConfig := new(action.Configuration)
-	debugLog := func(format string, v ...interface{}) {
-		logger.L().Debug(fmt.Sprintf(format, v...))
-	}
-	if err := actionConfig.Init(helmClient.settings.RESTClientGetter(), nameSpace, "secret", debugLog); err != nil {
-		logger.L().Error("Failed to initialize Helm action configuration for uninstall", zap.Error(err))
-		return &pb.UninstallChartResponse{
-			Code:    1,
-			Message: "Failed to initialize Helm configuration",
-		}, err
+	if _, err := cleanupKinds(req); err != nil {
+		return nil, err
 	}
-
-	// 创建 Uninstall action
-	uninstall := action.NewUninstall(actionConfig)
-	uninstall.Wait = true
-
-	_, err := uninstall.Run(req.GetReleaseName())
-	if err != nil {
-		logger.L().Error("Failed to uninstall chart", zap.Error(err))
-		return &pb.UninstallChartResponse{
-			Code:    1,
-			Message: "Failed to uninstall chart",
-		}, err
+	if _, err := uninstallTimeout(req); err != nil {
+		return nil, err
 	}
 
-	// 卸载 标签为 app.kubernetes.io/instance=releaseName 的deployment/statefulset/service
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
-		if err != nil {
-			return nil, status.Errorf(status.Code(err), "failed to create k8s config: %v", err)
-		}
-	}
-	clientset, err := kubernetes.NewForConfig(config)
+	op, err := submitOperation(ctx, operation.TypeUninstall, req.GetNamespace(), req.GetReleaseName(), req)
 	if err != nil {
-		return nil, status.Errorf(status.Code(err), "failed to create k8s clientset: %v", err)
+		return nil, err
 	}
-	labelSelector := fmt.Sprintf("app.kubernetes.io/instance=%s", req.GetReleaseName())
-
-	// 没做错误处理
-	// TODO
-	UninstallDep(ctx, labelSelector, nameSpace, clientset)
-	UninstallSts(ctx, labelSelector, nameSpace, clientset)
-	UninstallSvc(ctx, labelSelector, nameSpace, clientset)
-
 	return &pb.UninstallChartResponse{
-		Code:    0,
-		Message: "Chart uninstalled successfully",
+		Code:        0,
+		Message:     "Uninstall operation accepted",
+		OperationId: op.ID,
 	}, nil
 }
 
-// PushChartToHarbor 将 chart 文件推送到 Harbor 仓库
-func PushChartToHarbor(filePath, repoName, fileName string) (string, error) {
-	// 打开文件
-	file, err := os.Open(filePath)
-	if err != nil {
-		return "", fmt.Errorf("failed to open file: %v", err)
-	}
-	defer file.Close()
-
-	// 创建 multipart form
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
-
-	// 添加文件字段
-	part, err := writer.CreateFormFile("chart", fileName)
+// runUninstall 在操作 worker 中执行实际的卸载
+func runUninstall(ctx context.Context, req *pb.UninstallChartRequest) (*pb.UninstallChartResponse, error) {
+	nameSpace := req.GetNamespace()
+	releaseName := req.GetReleaseName()
+	kinds, err := cleanupKinds(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to create form file: %v", err)
-	}
-
-	if _, err := io.Copy(part, file); err != nil {
-		return "", fmt.Errorf("failed to copy file to form: %v", err)
+		return nil, err
 	}
-
-	writer.Close()
-
-	// 构建 Harbor API URL
-	harborApiUrl := fmt.Sprintf("%s/api/chartrepo/%s/charts",
-		strings.TrimSuffix(harborEntry.URL, "/chartrepo/library"), repoName)
-
-	// 创建 HTTP 请求
-	req, err := http.NewRequest("POST", harborApiUrl, &body)
+	timeout, err := uninstallTimeout(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %v", err)
+		return nil, err
 	}
 
-	// 设置请求头
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.SetBasicAuth(harborEntry.Username, harborEntry.Password)
-
-	// 发送请求
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-	}
-	resp, err := client.Do(req)
+	// 为指定 namespace 创建新的 action configuration
+	actionConfig, err := newActionConfig(nameSpace)
 	if err != nil {
-		return "", fmt.Errorf("failed to send request: %v", err)
+		logger.L().Error("Failed to initialize Helm action configuration for uninstall", zap.Error(err))
+		return &pb.UninstallChartResponse{
+			Code:    1,
+			Message: "Failed to initialize Helm configuration",
+		}, err
 	}
-	defer resp.Body.Close()
 
-	// 检查响应状态
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("harbor API error: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	// 创建 Uninstall action，等待资源删除时响应操作取消
+	withOperationContext(ctx, actionConfig)
+	uninstall := action.NewUninstall(actionConfig)
+	uninstall.Wait = true
+	uninstall.Timeout = timeout
+	uninstall.KeepHistory = req.GetKeepHistory()
+
+	message := "Chart uninstalled successfully"
+	operation.Report(ctx, "Uninstalling release "+releaseName)
+	if _, err := uninstall.Run(releaseName); err != nil {
+		// release 已不存在时继续清理残留资源，便于重试上次失败的清理
+		if !errors.Is(err, driver.ErrReleaseNotFound) {
+			logger.L().Error("Failed to uninstall chart", zap.Error(err))
+			return &pb.UninstallChartResponse{
+				Code:    1,
+				Message: fmt.Sprintf("Failed to uninstall chart: %v", err),
+			}, status.Errorf(codes.Internal, "uninstall failed: %v", err)
+		}
+		logger.L().Warn("Release not found, cleaning up remaining resources only", zap.String("release", releaseName))
+		message = "Release not found, remaining resources cleaned up"
 	}
 
-	logger.L().Info("Chart pushed to Harbor successfully",
-		zap.String("fileName", fileName),
-		zap.String("repoName", repoName))
-
-	// 返回 chart URL
-	return fmt.Sprintf("%s/charts/%s", harborEntry.URL, fileName), nil
-}
-
-func UninstallDep(ctx context.Context, labelSelector, namespace string, clientset *kubernetes.Clientset) {
-	deploymentsClient := clientset.AppsV1().Deployments(namespace)
-	deletePolicy := metav1.DeletePropagationForeground
-	if err := deploymentsClient.DeleteCollection(ctx, metav1.DeleteOptions{
-		PropagationPolicy: &deletePolicy,
-	}, metav1.ListOptions{
-		LabelSelector: labelSelector,
-	}); err != nil {
-		logger.L().Error("Failed to delete deployments", zap.Error(err))
-	} else {
-		logger.L().Info("Deployments deleted successfully", zap.String("labelSelector", labelSelector), zap.String("namespace", namespace))
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
-}
-
-func UninstallSts(ctx context.Context, labelSelector, namespace string, clientset *kubernetes.Clientset) {
-	statefulsetsClient := clientset.AppsV1().StatefulSets(namespace)
-	deletePolicy := metav1.DeletePropagationForeground
-	if err := statefulsetsClient.DeleteCollection(ctx, metav1.DeleteOptions{
-		PropagationPolicy: &deletePolicy,
-	}, metav1.ListOptions{
-		LabelSelector: labelSelector,
-	}); err != nil {
-		logger.L().Error("Failed to delete statefulsets", zap.Error(err))
-	} else {
-		logger.L().Info("Statefulsets deleted successfully", zap.String("labelSelector", labelSelector), zap.String("namespace", namespace))
+	if req.GetSkipCleanup() {
+		return &pb.UninstallChartResponse{Code: 0, Message: message}, nil
 	}
-}
-
-func UninstallSvc(ctx context.Context, labelSelector, namespace string, clientset *kubernetes.Clientset) {
-	servicesClient := clientset.CoreV1().Services(namespace)
-	deletePolicy := metav1.DeletePropagationForeground
 
-	svcList, err := servicesClient.List(ctx, metav1.ListOptions{
-		LabelSelector: labelSelector,
-	})
+	// 清理标签为 app.kubernetes.io/instance=releaseName 的残留资源
+	dyn, err := kubeDynamicClient()
 	if err != nil {
-		logger.L().Error("Failed to list services", zap.Error(err))
-		return
+		return &pb.UninstallChartResponse{
+			Code:    1,
+			Message: "Chart uninstalled but remaining resources were not cleaned up",
+		}, status.Errorf(codes.Internal, "failed to create dynamic client: %v", err)
 	}
+	operation.Report(ctx, "Cleaning up remaining resources")
+	deletions, failed := cleanupReleaseResources(ctx, dyn, nameSpace, releaseName, kinds)
 
-	var delErrs []string
-	for _, svc := range svcList.Items {
-		if err := servicesClient.Delete(ctx, svc.Name, metav1.DeleteOptions{
-			PropagationPolicy: &deletePolicy,
-		}); err != nil {
-			logger.L().Error("Failed to delete service", zap.String("service", svc.Name), zap.Error(err))
-			delErrs = append(delErrs, svc.Name)
-		} else {
-			logger.L().Info("Service deleted", zap.String("service", svc.Name), zap.String("namespace", namespace))
-		}
+	resp := &pb.UninstallChartResponse{
+		Code:      0,
+		Message:   message,
+		Deletions: deletions,
 	}
-
-	if len(delErrs) == 0 {
-		logger.L().Info("Services deleted successfully", zap.String("labelSelector", labelSelector), zap.String("namespace", namespace))
-	} else {
-		logger.L().Error("Some services failed to delete", zap.Strings("services", delErrs))
+	if failed > 0 {
+		resp.Code = 1
+		resp.Message = fmt.Sprintf("Chart uninstalled but %d resources could not be removed", failed)
+		return resp, status.Errorf(codes.Internal, "%s", resp.Message)
 	}
+	return resp, nil
 }
 
-func refreshChartRepository() error {
-	logger.L().Info("RefreshChartRepository called")
-
-	repoFile := helmClient.settings.RepositoryConfig
-	repoObj, err := repo.LoadFile(repoFile)
-	if err == nil {
-		for _, entry := range repoObj.Repositories {
-			chartRepo, err := repo.NewChartRepository(entry, getter.All(helmClient.settings))
-			if err == nil {
-				path, err := chartRepo.DownloadIndexFile()
-				if err != nil {
-					logger.L().Error("Failed to download index file from chart repository", zap.Error(err))
-					return err
-				} else {
-					logger.L().Info("Index file downloaded successfully", zap.String("repository", entry.Name), zap.String("path", path))
-				}
-			}
-		}
+func uninstallTimeout(req *pb.UninstallChartRequest) (time.Duration, error) {
+	value, ok := req.GetOptions()["timeout"]
+	if !ok || value == "" {
+		return defaultUninstallTimeout, nil
 	}
-
-	return err
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid timeout %q", value)
+	}
+	return timeout, nil
 }
 
-func parseAndPrintManifest(manifest string) error {
-	// 按 "---" 分割多个资源
-	resources := strings.Split(manifest, "---")
-	for _, res := range resources {
-		if strings.TrimSpace(res) == "" {
-			continue
-		}
-
-		// 将 YAML 解析为 unstructured.Unstructured 对象
-		obj := &unstructured.Unstructured{}
-		if err := yaml.Unmarshal([]byte(res), obj); err != nil {
-			return fmt.Errorf("failed to decode YAML: %v", err)
-		}
-
-		// 打印资源关键信息
-		fmt.Printf(
-			"Resource: %s/%s (Kind: %s, API: %s)\n",
-			obj.GetNamespace(),
-			obj.GetName(),
-			obj.GetKind(),
-			obj.GetAPIVersion(),
-		)
+// refreshChartRepository 刷新指定仓库的 index.yaml，供 LocateChart 解析 chart 版本
+func refreshChartRepository(entry *repo.Entry) error {
+	logger.L().Info("RefreshChartRepository called", zap.String("repository", entry.Name))
+	if _, err := repoIndexes.Refresh(entry); err != nil {
+		logger.L().Error("Failed to download index file from chart repository", zap.Error(err))
+		return err
 	}
 	return nil
 }
 
 func (s *HelmManagerServer) UpgradeChart(ctx context.Context, req *pb.UpgradeChartRequest) (*pb.UpgradeChartResponse, error) {
 	logger.L().Info("UpgradeChart called", zap.String("request", req.String()))
+	if req.GetChart().GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart.chart_name is required")
+	}
 	nameSpace := req.GetNamespace()
 	if nameSpace == "" {
 		nameSpace = "default"
 	}
-
-	// 创建 Upgrade Action
-	actionConfig := new(action.Configuration)
-	debugLog := func(format string, v ...interface{}) {
-		logger.L().Debug(fmt.Sprintf(format, v...))
+	if req.GetChart().GetRepoUrl() == "" && !registry.IsOCI(req.GetChart().GetChartName()) {
+		if _, err := getRepoEntry(req.GetChart().GetRepoName()); err != nil {
+			return nil, err
+		}
 	}
-	if err := actionConfig.Init(helmClient.settings.RESTClientGetter(), nameSpace, "secret", debugLog); err != nil {
-		logger.L().Error("Failed to initialize Helm action configuration for upgrade", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	// values 和 values 策略在提交前校验，避免操作排队后才失败
+	if err := applyValuesStrategy(&action.Upgrade{}, req.GetReuseValues(), req.GetResetValues(), req.GetResetThenReuseValues()); err != nil {
+		return nil, err
+	}
+	if _, err := chartSpecValues(req.GetChart()); err != nil {
+		return nil, err
 	}
 
-	upgrade := action.NewUpgrade(actionConfig)
-	upgrade.Namespace = nameSpace
-	upgrade.Force = req.Force
-	upgrade.ChartPathOptions.InsecureSkipTLSverify = true
-	upgrade.ChartPathOptions.Version = req.Chart.ChartVersion
-
-	// 2. 获取 Chart
-	chartRef := fmt.Sprintf("%s/%s", harborEntry.Name, req.Chart.ChartName)
-
-	chartPath, err := upgrade.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
+	op, err := submitOperation(ctx, operation.TypeUpgrade, nameSpace, req.GetReleaseName(), req)
 	if err != nil {
-		logger.L().Error("Failed to locate chart", zap.Error(err))
 		return nil, err
 	}
+	return &pb.UpgradeChartResponse{
+		Status:      op.Status,
+		OperationId: op.ID,
+	}, nil
+}
+
+// runUpgrade 在操作 worker 中执行实际的升级
+func runUpgrade(ctx context.Context, req *pb.UpgradeChartRequest) (*pb.UpgradeChartResponse, error) {
+	nameSpace := req.GetNamespace()
+	if nameSpace == "" {
+		nameSpace = "default"
+	}
 
-	chart, err := loader.Load(chartPath)
+	upgrade, chart, err := newUpgradeAction(ctx, nameSpace, req.GetChart())
 	if err != nil {
-		logger.L().Error("Failed to load chart", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "load chart failed: %v", err)
+		return nil, err
+	}
+	upgrade.Force = req.Force
+	if err := applyValuesStrategy(upgrade, req.GetReuseValues(), req.GetResetValues(), req.GetResetThenReuseValues()); err != nil {
+		return nil, err
 	}
 
-	// 3. 转换 values 类型
-	stringValues := req.GetChart().GetValues()
-	values := make(map[string]interface{})
-	for k, v := range stringValues {
-		values[k] = v
+	// 3. 解析 values
+	values, err := chartSpecValues(req.GetChart())
+	if err != nil {
+		return nil, err
 	}
 
 	// 4. 执行升级
-	release, err := upgrade.Run(req.GetReleaseName(), chart, values)
+	operation.Report(ctx, "Upgrading release "+req.GetReleaseName())
+	release, err := upgrade.RunWithContext(ctx, req.GetReleaseName(), chart, values)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "upgrade failed: %v", err)
 	}
@@ -711,105 +692,156 @@ func (s *HelmManagerServer) UpgradeChart(ctx context.Context, req *pb.UpgradeCha
 	}, nil
 }
 
-func (s *HelmManagerServer) RollbackChart(ctx context.Context, req *pb.RollbackChartRequest) (*pb.RollbackChartResponse, error) {
-	// 1. 创建 Rollback Action
-	rollback := action.NewRollback(helmClient.actionConfig)
-	// rollback.Recreate = false // Default value, can be set based on available fields in req
-
-	// Convert revision string to int and set it
-	revision, err := strconv.Atoi(req.GetRevision())
+// newUpgradeAction 创建指定 namespace 的 Upgrade action 并加载 spec 对应的 chart，
+// 升级和升级预览共用
+func newUpgradeAction(ctx context.Context, namespace string, spec *pb.ChartSpec) (*action.Upgrade, *chart.Chart, error) {
+	// 1. 创建 Upgrade Action
+	actionConfig, err := newActionConfig(namespace)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid revision: %v", err)
+		logger.L().Error("Failed to initialize Helm action configuration for upgrade", zap.Error(err))
+		return nil, nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
 	}
-	rollback.Version = revision
 
-	// 2. 执行回滚
-	if err := rollback.Run(req.GetReleaseName()); err != nil {
-		return nil, status.Errorf(codes.Internal, "rollback failed: %v", err)
+	upgrade := action.NewUpgrade(actionConfig)
+	upgrade.Namespace = namespace
+	upgrade.ChartPathOptions.InsecureSkipTLSverify = true
+	upgrade.ChartPathOptions.Version = spec.GetChartVersion()
+
+	// 2. 获取 Chart，指定了 repo_url 时直接从该地址查找，否则使用已配置的仓库
+	chartRef := spec.GetChartName()
+	switch {
+	case registry.IsOCI(spec.GetRepoUrl()):
+		chartRef = fmt.Sprintf("%s/%s", strings.TrimSuffix(spec.GetRepoUrl(), "/"), spec.GetChartName())
+		fallthrough
+	case spec.GetRepoUrl() == "":
+		ref, registryClient, err := resolveChartRef(spec.GetRepoName(), chartRef)
+		if err != nil {
+			return nil, nil, err
+		}
+		if registryClient != nil {
+			actionConfig.RegistryClient = registryClient
+			upgrade.SetRegistryClient(registryClient)
+		}
+		chartRef = ref
+	default:
+		upgrade.ChartPathOptions.RepoURL = spec.GetRepoUrl()
 	}
 
-	return &pb.RollbackChartResponse{}, nil
-}
+	operation.Report(ctx, "Locating chart "+chartRef)
+	chartPath, err := upgrade.ChartPathOptions.LocateChart(chartRef, helmClient.settings)
+	if err != nil {
+		logger.L().Error("Failed to locate chart", zap.Error(err))
+		return nil, nil, err
+	}
 
-func (s *HelmManagerServer) ListInstalledCharts(ctx context.Context, req *pb.ListInstalledChartsRequest) (*pb.ListInstalledChartsResponse, error) {
-	logger.L().Info("ListInstalledCharts called", zap.String("request", req.String()))
-	namespace := req.GetNamespace()
-	if namespace == "" {
-		return nil, status.Errorf(codes.Internal, "namespace is required")
+	chart, err := loadChart(ctx, chartPath)
+	if err != nil {
+		return nil, nil, err
 	}
+	return upgrade, chart, nil
+}
 
-	// 为指定 namespace 创建专用的 action configuration
-	actionConfig := new(action.Configuration)
-	debugLog := func(format string, v ...interface{}) {
-		logger.L().Debug(fmt.Sprintf(format, v...))
+func (s *HelmManagerServer) RollbackChart(ctx context.Context, req *pb.RollbackChartRequest) (*pb.RollbackChartResponse, error) {
+	logger.L().Info("RollbackChart called", zap.String("request", req.String()))
+	if req.GetReleaseName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "release_name is required")
 	}
-	if err := actionConfig.Init(helmClient.settings.RESTClientGetter(), namespace, "secret", debugLog); err != nil {
-		logger.L().Error("Failed to initialize Helm action configuration for list", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	if _, err := rollbackRevision(req); err != nil {
+		return nil, err
+	}
+	if _, err := rollbackTimeout(req); err != nil {
+		return nil, err
+	}
+	nameSpace := req.GetNamespace()
+	if nameSpace == "" {
+		nameSpace = "default"
 	}
 
-	list := action.NewList(actionConfig)
-	list.All = true
-	// 如果请求中没有指定 namespace，则查询所有 namespace
-	list.AllNamespaces = req.GetNamespace() == ""
-	releaseName := req.GetReleaseName()
-
-	logger.L().Info("Listing installed charts", zap.String("namespace", namespace), zap.String("release_name", releaseName))
-
-	releases, err := list.Run()
-	if len(releases) == 0 {
-		logger.L().Info("No installed charts found")
-		return &pb.ListInstalledChartsResponse{
-			Code:    0,
-			Message: "No installed charts found",
-			Success: true,
-			Data:    nil,
-		}, nil
+	op, err := submitOperation(ctx, operation.TypeRollback, nameSpace, req.GetReleaseName(), req)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.RollbackChartResponse{
+		Status:      op.Status,
+		OperationId: op.ID,
+	}, nil
+}
 
+// runRollback 在操作 worker 中执行实际的回滚
+func runRollback(ctx context.Context, req *pb.RollbackChartRequest) (*pb.RollbackChartResponse, error) {
+	nameSpace := req.GetNamespace()
+	if nameSpace == "" {
+		nameSpace = "default"
+	}
+	revision, err := rollbackRevision(req)
 	if err != nil {
-		logger.L().Error("Failed to list installed charts", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "list installed charts failed: %v", err)
+		return nil, err
+	}
+	timeout, err := rollbackTimeout(req)
+	if err != nil {
+		return nil, err
 	}
 
-	var chartInfos []*pb.InstalledChart
-	for _, rel := range releases {
-		if req.GetNamespace() != "" && rel.Namespace != req.GetNamespace() {
-			continue
-		}
+	// 1. 创建目标 namespace 的 Rollback Action，等待资源就绪时响应操作取消
+	actionConfig, err := newActionConfig(nameSpace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration for rollback", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	withOperationContext(ctx, actionConfig)
+	rollback := action.NewRollback(actionConfig)
+	rollback.Version = revision
+	rollback.Wait = req.GetWait()
+	rollback.Timeout = timeout
+	rollback.CleanupOnFail = req.GetCleanupOnFail()
+	rollback.Force = req.GetForce()
 
-		info := &pb.InstalledChart{
-			Name:         rel.Name,
-			Namespace:    rel.Namespace,
-			ChartVersion: rel.Chart.Metadata.Version,
-			AppVersion:   rel.Chart.Metadata.AppVersion,
-			ChartName:    rel.Chart.Metadata.Name,
-			Status:       rel.Info.Status.String(),
-		}
+	// 2. 执行回滚，revision 为 0 时回滚到上一个版本
+	target := "previous revision"
+	if revision > 0 {
+		target = fmt.Sprintf("revision %d", revision)
+	}
+	operation.Report(ctx, fmt.Sprintf("Rolling back release %s to %s", req.GetReleaseName(), target))
+	if err := rollback.Run(req.GetReleaseName()); err != nil {
+		logger.L().Error("Failed to rollback release", zap.String("release", req.GetReleaseName()), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "rollback failed: %v", err)
+	}
 
-		if req.WithManifest {
-			info.Manifest = rel.Manifest
-		}
+	// 3. 返回回滚后的 release 状态
+	release, err := action.NewGet(actionConfig).Run(req.GetReleaseName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get release after rollback failed: %v", err)
+	}
+	logger.L().Info("Release rolled back", zap.String("release", release.Name), zap.Int("revision", release.Version))
+	return &pb.RollbackChartResponse{
+		Status:          release.Info.Status.String(),
+		CurrentRevision: strconv.Itoa(release.Version),
+		ChartVersion:    release.Chart.Metadata.Version,
+		Description:     release.Info.Description,
+	}, nil
+}
 
-		chartInfos = append(chartInfos, info)
+// rollbackRevision 解析目标版本号，为空时等同于 0（上一个版本）
+func rollbackRevision(req *pb.RollbackChartRequest) (int, error) {
+	if req.GetRevision() == "" {
+		return 0, nil
 	}
-
-	var anyData []*anypb.Any
-	for _, chart := range chartInfos {
-		anyChart, err := anypb.New(chart)
-		if err != nil {
-			logger.L().Error("Failed to marshal InstalledChart to Any", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "marshal chart data failed: %v", err)
-		}
-		anyData = append(anyData, anyChart)
+	revision, err := strconv.Atoi(req.GetRevision())
+	if err != nil || revision < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid revision %q", req.GetRevision())
 	}
+	return revision, nil
+}
 
-	return &pb.ListInstalledChartsResponse{
-		Code:    0,
-		Message: fmt.Sprintf("Found %d installed charts", len(chartInfos)),
-		Success: true,
-		Data:    anyData,
-	}, nil
+func rollbackTimeout(req *pb.RollbackChartRequest) (time.Duration, error) {
+	if req.GetTimeout() == "" {
+		return defaultRollbackTimeout, nil
+	}
+	timeout, err := time.ParseDuration(req.GetTimeout())
+	if err != nil || timeout <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid timeout %q", req.GetTimeout())
+	}
+	return timeout, nil
 }
 
 func GetPodList(ctx context.Context, namespace, releaseName string) (*v1.PodList, error) {
@@ -874,46 +906,8 @@ func (s *HelmManagerServer) ListPodStatus(ctx context.Context, req *pb.ListPodSt
 
 	// 构建 PodStatus 列表
 	var podStatuses []*pb.PodStatus
-	for _, pod := range podList.Items {
-		restartCount := 0
-		podStatus := &pb.PodStatus{
-			Name:       pod.Name,
-			Ip:         pod.Status.PodIP,
-			Phase:      string(pod.Status.Phase),
-			Node:       pod.Spec.NodeName,
-			Labels:     pod.Labels,
-			Containers: make([]*pb.ContainerStatus, 0, len(pod.Status.ContainerStatuses)),
-		}
-
-		readyCount := 0
-		for _, container := range pod.Status.ContainerStatuses {
-			containerStatus := &pb.ContainerStatus{
-				Name:  container.Name,
-				Image: container.Image,
-				State: container.State.String(),
-				Ready: container.Ready,
-			}
-			if container.Ready {
-				readyCount++
-			}
-			podStatus.Containers = append(podStatus.Containers, containerStatus)
-			restartCount += int(container.RestartCount)
-		}
-		podStatus.Restarts = int32(restartCount)
-		readStatus := fmt.Sprintf("%d/%d", readyCount, len(pod.Status.ContainerStatuses))
-		podStatus.Ready = readStatus
-		age := metav1.Now().Sub(pod.CreationTimestamp.Time) // 计算 Pod 的年龄
-		// 根据age的大小，分别以秒/小时/天来统计
-		switch {
-		case age < time.Minute:
-			podStatus.Age = fmt.Sprintf("%d秒", int(age.Seconds()))
-		case age < time.Hour:
-			podStatus.Age = fmt.Sprintf("%d分钟", int(age.Minutes()))
-		default:
-			podStatus.Age = fmt.Sprintf("%d小时", int(age.Hours()))
-		}
-		podStatus.Status = calculatePodStatus(&pod)
-		podStatuses = append(podStatuses, podStatus)
+	for i := range podList.Items {
+		podStatuses = append(podStatuses, toPbPodStatus(&podList.Items[i]))
 	}
 
 	return &pb.ListPodStatusResponse{
@@ -923,3 +917,46 @@ func (s *HelmManagerServer) ListPodStatus(ctx context.Context, req *pb.ListPodSt
 		Data:    &pb.PodsStatusList{Pods: podStatuses},
 	}, nil
 }
+
+// toPbPodStatus 转换 Pod 的状态信息
+func toPbPodStatus(pod *v1.Pod) *pb.PodStatus {
+	restartCount := 0
+	podStatus := &pb.PodStatus{
+		Name:       pod.Name,
+		Ip:         pod.Status.PodIP,
+		Phase:      string(pod.Status.Phase),
+		Node:       pod.Spec.NodeName,
+		Labels:     pod.Labels,
+		Containers: make([]*pb.ContainerStatus, 0, len(pod.Status.ContainerStatuses)),
+	}
+
+	readyCount := 0
+	for _, container := range pod.Status.ContainerStatuses {
+		containerStatus := &pb.ContainerStatus{
+			Name:  container.Name,
+			Image: container.Image,
+			State: container.State.String(),
+			Ready: container.Ready,
+		}
+		if container.Ready {
+			readyCount++
+		}
+		podStatus.Containers = append(podStatus.Containers, containerStatus)
+		restartCount += int(container.RestartCount)
+	}
+	podStatus.Restarts = int32(restartCount)
+	readStatus := fmt.Sprintf("%d/%d", readyCount, len(pod.Status.ContainerStatuses))
+	podStatus.Ready = readStatus
+	age := metav1.Now().Sub(pod.CreationTimestamp.Time) // 计算 Pod 的年龄
+	// 根据age的大小，分别以秒/小时/天来统计
+	switch {
+	case age < time.Minute:
+		podStatus.Age = fmt.Sprintf("%d秒", int(age.Seconds()))
+	case age < time.Hour:
+		podStatus.Age = fmt.Sprintf("%d分钟", int(age.Minutes()))
+	default:
+		podStatus.Age = fmt.Sprintf("%d小时", int(age.Hours()))
+	}
+	podStatus.Status = calculatePodStatus(pod)
+	return podStatus
+}
@@ -0,0 +1,95 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const defaultHistoryMax = 256
+
+func (s *HelmManagerServer) GetReleaseHistory(ctx context.Context, req *pb.GetReleaseHistoryRequest) (*pb.GetReleaseHistoryResponse, error) {
+	logger.L().Info("GetReleaseHistory called", zap.String("request", req.String()))
+	if req.GetReleaseName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "release_name is required")
+	}
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	history := action.NewHistory(actionConfig)
+	history.Max = defaultHistoryMax
+	if req.GetMax() > 0 {
+		history.Max = int(req.GetMax())
+	}
+	releases, err := history.Run(req.GetReleaseName())
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, status.Errorf(codes.NotFound, "release %q not found in namespace %q", req.GetReleaseName(), namespace)
+	}
+	if err != nil {
+		logger.L().Error("Failed to get release history", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "get release history failed: %v", err)
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+	if len(releases) > history.Max {
+		releases = releases[:history.Max]
+	}
+
+	revisions := make([]*pb.ReleaseRevision, 0, len(releases))
+	for _, rel := range releases {
+		revisions = append(revisions, toPbReleaseRevision(rel))
+	}
+	return &pb.GetReleaseHistoryResponse{
+		Code:      0,
+		Message:   fmt.Sprintf("Found %d revisions", len(revisions)),
+		Success:   true,
+		Revisions: revisions,
+	}, nil
+}
+
+func toPbReleaseRevision(rel *release.Release) *pb.ReleaseRevision {
+	revision := &pb.ReleaseRevision{
+		Revision: int32(rel.Version),
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		revision.Chart = rel.Chart.Metadata.Name
+		revision.ChartVersion = rel.Chart.Metadata.Version
+		revision.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+	if rel.Info != nil {
+		revision.Status = rel.Info.Status.String()
+		revision.Description = rel.Info.Description
+		revision.FirstDeployed = formatHelmTime(rel.Info.FirstDeployed.Time)
+		revision.LastDeployed = formatHelmTime(rel.Info.LastDeployed.Time)
+		revision.Deleted = formatHelmTime(rel.Info.Deleted.Time)
+	}
+	return revision
+}
+
+func formatHelmTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
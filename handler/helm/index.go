@@ -0,0 +1,192 @@
+package helm
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/repo"
+
+	"jos-deployment/pkg/logger"
+)
+
+const defaultIndexRefreshInterval = 5 * time.Minute
+
+// repoIndexes 全局的仓库索引缓存
+var repoIndexes = &indexCache{indexes: map[string]*cachedIndex{}}
+
+// indexCache 缓存各仓库的 index.yaml，后台定期通过 ETag/If-Modified-Since 条件请求刷新，
+// 仓库未变化时 registry 只返回 304，不再重复下载和解析整个索引
+type indexCache struct {
+	mu        sync.RWMutex
+	indexes   map[string]*cachedIndex
+	startOnce sync.Once
+}
+
+type cachedIndex struct {
+	entry        repo.Entry
+	index        *repo.IndexFile
+	etag         string
+	lastModified string
+}
+
+// Get 返回仓库的索引，缓存中没有或仓库配置已变化时同步下载
+func (c *indexCache) Get(entry *repo.Entry) (*repo.IndexFile, error) {
+	c.startOnce.Do(func() {
+		go c.refreshLoop(indexRefreshInterval())
+	})
+
+	c.mu.RLock()
+	cached, ok := c.indexes[entry.Name]
+	c.mu.RUnlock()
+	if ok && cached.entry == *entry {
+		return cached.index, nil
+	}
+	return c.Refresh(entry)
+}
+
+// Refresh 立即刷新仓库索引，已有缓存时使用条件请求
+func (c *indexCache) Refresh(entry *repo.Entry) (*repo.IndexFile, error) {
+	c.mu.RLock()
+	cached := c.indexes[entry.Name]
+	c.mu.RUnlock()
+	if cached != nil && cached.entry != *entry {
+		cached = nil
+	}
+
+	next, err := fetchIndex(entry, cached)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.indexes[entry.Name] = next
+	c.mu.Unlock()
+	return next.index, nil
+}
+
+// Invalidate 删除仓库的缓存，仓库配置变更或删除时调用
+func (c *indexCache) Invalidate(name string) {
+	c.mu.Lock()
+	delete(c.indexes, name)
+	c.mu.Unlock()
+}
+
+func (c *indexCache) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.mu.RLock()
+		entries := make([]repo.Entry, 0, len(c.indexes))
+		for _, cached := range c.indexes {
+			entries = append(entries, cached.entry)
+		}
+		c.mu.RUnlock()
+
+		for i := range entries {
+			if _, err := c.Refresh(&entries[i]); err != nil {
+				logger.L().Warn("Failed to refresh repository index", zap.String("repository", entries[i].Name), zap.Error(err))
+			}
+		}
+	}
+}
+
+// indexRefreshInterval 后台刷新间隔，可通过 INDEX_REFRESH_INTERVAL（如 "1m"）配置
+func indexRefreshInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("INDEX_REFRESH_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return defaultIndexRefreshInterval
+}
+
+// fetchIndex 下载仓库的 index.yaml。prev 不为空时带上 ETag/Last-Modified 做条件请求，
+// 返回 304 时沿用 prev 的索引。下载到的索引同时写入 helm 的缓存目录，供 LocateChart 使用
+func fetchIndex(entry *repo.Entry, prev *cachedIndex) (*cachedIndex, error) {
+	indexURL, err := repo.ResolveReferenceURL(entry.URL, "index.yaml")
+	if err != nil {
+		return nil, err
+	}
+	cacheFile := filepath.Join(helmClient.settings.RepositoryCache, helmpath.CacheIndexFile(entry.Name))
+	if prev != nil && !fileExists(cacheFile) {
+		prev = nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if prev != nil {
+		if prev.etag != "" {
+			req.Header.Set("If-None-Match", prev.etag)
+		}
+		if prev.lastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.lastModified)
+		}
+	}
+	if entry.Username != "" || entry.Password != "" {
+		req.SetBasicAuth(entry.Username, entry.Password)
+	}
+
+	tlsConfig, err := repoTLSConfig(entry)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Timeout: 2 * time.Minute,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", indexURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && prev != nil:
+		return prev, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("failed to fetch %s: %s", indexURL, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", indexURL, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(cacheFile, data, 0644); err != nil {
+		return nil, err
+	}
+	index, err := repo.LoadIndexFile(cacheFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load index file: %w", err)
+	}
+
+	// 与 helm repo update 一致，同时生成 chart 名称列表文件
+	var charts strings.Builder
+	for name := range index.Entries {
+		fmt.Fprintln(&charts, name)
+	}
+	chartsFile := filepath.Join(helmClient.settings.RepositoryCache, helmpath.CacheChartsFile(entry.Name))
+	if err := os.WriteFile(chartsFile, []byte(charts.String()), 0644); err != nil {
+		logger.L().Warn("Failed to write charts file", zap.String("file", chartsFile), zap.Error(err))
+	}
+
+	logger.L().Info("Index file downloaded successfully", zap.String("repository", entry.Name))
+	return &cachedIndex{
+		entry:        *entry,
+		index:        index,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
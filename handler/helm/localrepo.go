@@ -0,0 +1,153 @@
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/repo"
+
+	"jos-deployment/pkg/chartrepo"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	// LocalRepoPath 内置 chart 仓库在 HTTP 网关上的路径前缀
+	LocalRepoPath = "/chartrepo/"
+
+	localRepoName          = "local"
+	defaultLocalRepoListen = "127.0.0.1:8081"
+)
+
+var (
+	localRepo    *chartrepo.Repository
+	localRepoURL string
+)
+
+// InitLocalRepo 配置了 LOCAL_CHART_REPO_DIR 时启用内置 chart 仓库：上传的 chart 保存到该目录，
+// 仓库以 local 为名注册到 HelmClient 并作为默认仓库。HelmClient 拉取 chart 时不携带 token，
+// 因此仓库另外以免认证方式监听在仅本机可访问的 LOCAL_CHART_REPO_LISTEN（默认 127.0.0.1:8081）上，
+// LOCAL_CHART_REPO_URL 为 HelmClient 访问仓库的地址，默认指向该监听地址。
+// 返回的 handler 供挂载到需要认证的 HTTP 网关，未启用时返回 nil
+func InitLocalRepo() http.Handler {
+	dir := os.Getenv("LOCAL_CHART_REPO_DIR")
+	if dir == "" {
+		return nil
+	}
+	backend, err := chartrepo.NewFileBackend(dir)
+	if err != nil {
+		logger.L().Error("Failed to initialize local chart repository", zap.Error(err))
+		return nil
+	}
+	r, err := chartrepo.New(backend)
+	if err != nil {
+		logger.L().Error("Failed to load local chart repository", zap.Error(err))
+		return nil
+	}
+	listen := os.Getenv("LOCAL_CHART_REPO_LISTEN")
+	if listen == "" {
+		listen = defaultLocalRepoListen
+	}
+	addr, err := serveLocalRepo(listen, r)
+	if err != nil {
+		logger.L().Error("Failed to serve local chart repository", zap.Error(err))
+		return nil
+	}
+	url := strings.TrimSuffix(os.Getenv("LOCAL_CHART_REPO_URL"), "/")
+	if url == "" {
+		url = "http://" + addr + strings.TrimSuffix(LocalRepoPath, "/")
+	}
+	if err := registerLocalRepo(url); err != nil {
+		logger.L().Error("Failed to register local chart repository", zap.Error(err))
+		return nil
+	}
+	localRepo, localRepoURL = r, url
+	logger.L().Info("Local chart repository enabled", zap.String("url", url))
+	return r
+}
+
+// serveLocalRepo 在回环地址上提供免认证的仓库访问，返回实际监听的地址
+func serveLocalRepo(listen string, handler http.Handler) (string, error) {
+	host, _, err := net.SplitHostPort(listen)
+	if err != nil {
+		return "", fmt.Errorf("invalid listen address %q: %w", listen, err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return "", fmt.Errorf("listen address %q is not a loopback address", listen)
+	}
+	ln, err := net.Listen("tcp", listen)
+	if err != nil {
+		return "", err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(LocalRepoPath, http.StripPrefix(strings.TrimSuffix(LocalRepoPath, "/"), handler))
+	go func() {
+		if err := http.Serve(ln, mux); err != nil {
+			logger.L().Error("Local chart repository listener stopped", zap.Error(err))
+		}
+	}()
+	return ln.Addr().String(), nil
+}
+
+// registerLocalRepo 把内置仓库写入 repositories.yaml，地址变化时覆盖旧配置
+func registerLocalRepo(url string) error {
+	repoMu.Lock()
+	defer repoMu.Unlock()
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return err
+	}
+	repoFile.Update(&repo.Entry{Name: localRepoName, URL: url})
+	if err := repoFile.WriteFile(helmClient.settings.RepositoryConfig, 0644); err != nil {
+		return err
+	}
+	repoIndexes.Invalidate(localRepoName)
+	return nil
+}
+
+// defaultRepoName 请求未指定仓库时使用的仓库，启用内置仓库后为 local
+func defaultRepoName() string {
+	if localRepo != nil {
+		return localRepoName
+	}
+	return defaultHarborEntry().Name
+}
+
+// PublishChart 发布校验过的 chart 包：启用内置仓库时保存到内置仓库，否则推送到 Harbor。
+// 返回 chart 包的下载地址或 oci:// 引用
+func PublishChart(archivePath, provPath, repoName, fileName string) (string, error) {
+	if localRepo == nil {
+		ref, err := PushChartToHarbor(archivePath, repoName, fileName)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "push chart failed: %v", err)
+		}
+		return ref, nil
+	}
+
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "read chart archive failed: %v", err)
+	}
+	var prov []byte
+	if provPath != "" {
+		if prov, err = os.ReadFile(provPath); err != nil {
+			return "", status.Errorf(codes.Internal, "read provenance file failed: %v", err)
+		}
+	}
+	cv, err := localRepo.AddChart(data, prov)
+	if errors.Is(err, chartrepo.ErrChartExists) {
+		return "", status.Errorf(codes.AlreadyExists, "%v", err)
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "store chart failed: %v", err)
+	}
+	repoIndexes.Invalidate(localRepoName)
+	logger.L().Info("Chart stored in local repository", zap.String("chart", cv.Name), zap.String("version", cv.Version))
+	return localRepoURL + "/" + cv.URLs[0], nil
+}
@@ -0,0 +1,308 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/kube"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/db"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/model"
+	"jos-deployment/pkg/operation"
+)
+
+const (
+	defaultOperationWorkers   = 4
+	defaultOperationQueueSize = 100
+)
+
+var (
+	operationManager *operation.Manager
+	operationOnce    sync.Once
+)
+
+// operations 返回全局的异步操作管理器。sqlite 已初始化时操作记录落库并在启动时恢复，
+// 否则退化为内存存储
+func operations() *operation.Manager {
+	operationOnce.Do(func() {
+		var store operation.Store
+		if db.DB.SqliteDb != nil {
+			store = &db.DB
+		} else {
+			logger.L().Warn("SQLite database not initialized, operations are kept in memory only")
+			store = operation.NewMemoryStore()
+		}
+
+		workers := defaultOperationWorkers
+		if n, err := strconv.Atoi(os.Getenv("OPERATION_WORKERS")); err == nil && n > 0 {
+			workers = n
+		}
+		m := operation.NewManager(store, workers, defaultOperationQueueSize)
+		m.Register(operation.TypeInstall, operationBuilder(runInstall))
+		m.Register(operation.TypeUpgrade, operationBuilder(runUpgrade))
+		m.Register(operation.TypeUninstall, operationBuilder(runUninstall))
+		m.Register(operation.TypeRollback, operationBuilder(runRollback))
+		if err := m.Recover(); err != nil {
+			logger.L().Error("Failed to recover operations", zap.Error(err))
+		}
+		operationManager = m
+	})
+	return operationManager
+}
+
+// InitOperations 在服务启动时初始化操作管理器，恢复上次进程遗留的操作
+func InitOperations() {
+	operations()
+}
+
+// operationBuilder 把具体的 runXxx 函数适配为 operation.Builder
+func operationBuilder[Req proto.Message, Resp proto.Message](run func(context.Context, Req) (Resp, error)) operation.Builder {
+	return func(msg proto.Message) (operation.Func, error) {
+		req, ok := msg.(Req)
+		if !ok {
+			return nil, fmt.Errorf("unexpected request type %T", msg)
+		}
+		return func(ctx context.Context) (proto.Message, error) {
+			resp, err := run(ctx, req)
+			if !resp.ProtoReflect().IsValid() {
+				return nil, err
+			}
+			return resp, err
+		}, nil
+	}
+}
+
+// contextKubeClient 让 KubeClient 的等待在 ctx 取消时立即返回。helm 的 Rollback 和 Uninstall
+// 没有 RunWithContext，耗时主要在等待资源就绪或删除，替换 KubeClient 后即可响应操作取消；
+// 被放弃的等待在后台持续到各自的超时
+type contextKubeClient struct {
+	*kube.Client
+	ctx context.Context
+}
+
+// withOperationContext 使 actionConfig 中的等待响应 ctx 取消
+func withOperationContext(ctx context.Context, actionConfig *action.Configuration) {
+	if client, ok := actionConfig.KubeClient.(*kube.Client); ok {
+		actionConfig.KubeClient = &contextKubeClient{Client: client, ctx: ctx}
+	}
+}
+
+func (c *contextKubeClient) Wait(resources kube.ResourceList, timeout time.Duration) error {
+	return c.wait(func() error { return c.Client.Wait(resources, timeout) })
+}
+
+func (c *contextKubeClient) WaitWithJobs(resources kube.ResourceList, timeout time.Duration) error {
+	return c.wait(func() error { return c.Client.WaitWithJobs(resources, timeout) })
+}
+
+func (c *contextKubeClient) WaitForDelete(resources kube.ResourceList, timeout time.Duration) error {
+	return c.wait(func() error { return c.Client.WaitForDelete(resources, timeout) })
+}
+
+func (c *contextKubeClient) WatchUntilReady(resources kube.ResourceList, timeout time.Duration) error {
+	return c.wait(func() error { return c.Client.WatchUntilReady(resources, timeout) })
+}
+
+func (c *contextKubeClient) wait(fn func() error) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return err
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+// submitOperation 提交异步操作，操作人取自认证后的调用方身份，不使用请求中的 user_id
+func submitOperation(ctx context.Context, opType, namespace, releaseName string, req proto.Message) (*model.Operation, error) {
+	var userID string
+	if identity, ok := auth.FromContext(ctx); ok {
+		userID = identity.UserID
+	}
+	op, err := operations().Submit(opType, namespace, releaseName, userID, req)
+	if err != nil {
+		logger.L().Error("Failed to submit operation", zap.String("type", opType), zap.Error(err))
+		if errors.Is(err, operation.ErrQueueFull) {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many pending operations, try again later")
+		}
+		return nil, status.Errorf(codes.Internal, "submit %s operation failed: %v", opType, err)
+	}
+	logger.L().Info("Operation submitted", zap.String("id", op.ID), zap.String("type", opType))
+	return op, nil
+}
+
+func (s *HelmManagerServer) GetOperation(ctx context.Context, req *pb.GetOperationRequest) (*pb.GetOperationResponse, error) {
+	logger.L().Info("GetOperation called", zap.String("request", req.String()))
+	op, err := operations().Get(req.GetOperationId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	data, err := toPbOperation(op)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "decode operation failed: %v", err)
+	}
+	return &pb.GetOperationResponse{
+		Code:    0,
+		Message: "Operation retrieved successfully",
+		Success: true,
+		Data:    data,
+	}, nil
+}
+
+func (s *HelmManagerServer) ListOperations(ctx context.Context, req *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	logger.L().Info("ListOperations called", zap.String("request", req.String()))
+	filter := db.OperationFilter{
+		Namespace:   req.GetNamespace(),
+		ReleaseName: req.GetReleaseName(),
+		Type:        req.GetType(),
+	}
+	if req.GetStatus() != "" {
+		filter.Statuses = []string{req.GetStatus()}
+	}
+
+	offset, limit := 0, 0
+	if req.GetLimit() > 0 && req.GetSize() > 0 {
+		offset = int((req.GetLimit() - 1) * req.GetSize())
+		limit = int(req.GetSize())
+	}
+	var ops []model.Operation
+	var total int64
+	var err error
+	var visible func(string) (bool, error)
+	if filter.Namespace == "" {
+		// 列出全部命名空间时只保留调用方有权访问的命名空间
+		if visible, err = namespaceFilter(ctx, pb.HelmManagerService_ListOperations_FullMethodName); err != nil {
+			return nil, err
+		}
+	}
+	if visible == nil {
+		if ops, total, err = operations().List(filter, offset, limit); err != nil {
+			return nil, status.Errorf(codes.Internal, "list operations failed: %v", err)
+		}
+	} else if ops, total, err = visibleOperations(filter, visible, offset, limit); err != nil {
+		return nil, err
+	}
+
+	data := &pb.ListOperationsData{Total: int32(total)}
+	for i := range ops {
+		op, err := toPbOperation(&ops[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "decode operation failed: %v", err)
+		}
+		data.Operations = append(data.Operations, op)
+	}
+	return &pb.ListOperationsResponse{
+		Code:    0,
+		Message: fmt.Sprintf("Found %d operations", total),
+		Success: true,
+		Data:    data,
+	}, nil
+}
+
+// visibleOperations 读取全部满足条件的操作，按命名空间过滤后再分页
+func visibleOperations(filter db.OperationFilter, visible func(string) (bool, error), offset, limit int) ([]model.Operation, int64, error) {
+	all, _, err := operations().List(filter, 0, 0)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list operations failed: %v", err)
+	}
+	ops := all[:0]
+	for _, op := range all {
+		ok, err := visible(op.Namespace)
+		if err != nil {
+			return nil, 0, err
+		}
+		if ok {
+			ops = append(ops, op)
+		}
+	}
+	total := int64(len(ops))
+	if offset > len(ops) {
+		offset = len(ops)
+	}
+	ops = ops[offset:]
+	if limit > 0 && limit < len(ops) {
+		ops = ops[:limit]
+	}
+	return ops, total, nil
+}
+
+func (s *HelmManagerServer) CancelOperation(ctx context.Context, req *pb.CancelOperationRequest) (*pb.CancelOperationResponse, error) {
+	logger.L().Info("CancelOperation called", zap.String("request", req.String()))
+	op, err := operations().Cancel(req.GetOperationId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	data, err := toPbOperation(op)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "decode operation failed: %v", err)
+	}
+	return &pb.CancelOperationResponse{
+		Code:    0,
+		Message: "Operation cancellation requested",
+		Success: true,
+		Data:    data,
+	}, nil
+}
+
+// OperationNamespace 返回异步操作所在的命名空间，操作不存在时为空
+func OperationNamespace(id string) string {
+	op, err := operations().Get(id)
+	if err != nil {
+		return ""
+	}
+	return op.Namespace
+}
+
+func operationError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrOperationNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, operation.ErrFinished):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func toPbOperation(op *model.Operation) (*pb.Operation, error) {
+	result, err := operation.DecodeAny(op.Result)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.Operation{
+		Id:          op.ID,
+		Type:        op.Type,
+		Namespace:   op.Namespace,
+		ReleaseName: op.ReleaseName,
+		Status:      op.Status,
+		Message:     op.Message,
+		Result:      result,
+		UserId:      op.UserID,
+		CreatedAt:   timestamppb.New(op.CreateDate),
+	}
+	if op.StartDate != nil {
+		out.StartedAt = timestamppb.New(*op.StartDate)
+	}
+	if op.FinishDate != nil {
+		out.FinishedAt = timestamppb.New(*op.FinishDate)
+	}
+	return out, nil
+}
@@ -0,0 +1,40 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"helm.sh/helm/v3/pkg/kube"
+)
+
+func TestContextKubeClientWaitCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &contextKubeClient{Client: &kube.Client{}, ctx: ctx}
+
+	release := make(chan struct{})
+	defer close(release)
+	done := make(chan error, 1)
+	go func() {
+		done <- client.wait(func() error {
+			<-release
+			return nil
+		})
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("wait() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait() did not return after the operation was cancelled")
+	}
+
+	called := false
+	if err := client.wait(func() error { called = true; return nil }); !errors.Is(err, context.Canceled) || called {
+		t.Errorf("wait() after cancel = %v, called = %v, want context.Canceled without waiting", err, called)
+	}
+}
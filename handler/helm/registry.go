@@ -0,0 +1,294 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// ociHost 返回 oci:// 引用中的 registry 地址（host[:port]）
+func ociHost(ref string) string {
+	ref = strings.TrimPrefix(ref, fmt.Sprintf("%s://", registry.OCIScheme))
+	host, _, _ := strings.Cut(ref, "/")
+	return host
+}
+
+// isPlainHTTPRegistry 判断 registry 是否通过 http 访问，
+// 由 OCI_PLAIN_HTTP_REGISTRIES 配置（逗号分隔的 host:port，类似 docker 的 insecure-registries）
+func isPlainHTTPRegistry(host string) bool {
+	for _, h := range strings.Split(os.Getenv("OCI_PLAIN_HTTP_REGISTRIES"), ",") {
+		if strings.TrimSpace(h) == host {
+			return true
+		}
+	}
+	return false
+}
+
+// registryEntryForHost 查找指向该 registry 的仓库配置，用于获取凭据和 TLS 设置
+func registryEntryForHost(host string) *repo.Entry {
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		logger.L().Warn("Failed to load repository file", zap.Error(err))
+		return nil
+	}
+	for _, entry := range repoFile.Repositories {
+		if registry.IsOCI(entry.URL) && ociHost(entry.URL) == host {
+			return entry
+		}
+	}
+	// 默认 harbor 仓库同时提供 OCI 接口
+	harbor := defaultHarborEntry()
+	if u, err := url.Parse(harbor.URL); err == nil && u.Host == host {
+		return &harbor
+	}
+	return nil
+}
+
+// newRegistryClient 创建访问指定 registry 的客户端。仓库配置了用户名时使用配置中的凭据，
+// 否则回退到 helm registry login 保存的凭据
+func newRegistryClient(host string, entry *repo.Entry) (*registry.Client, error) {
+	return newRegistriesClient(map[string]*repo.Entry{host: entry})
+}
+
+// newRegistriesClient 创建可同时访问多个 registry 的客户端，hosts 为 registry 地址到仓库配置的映射（可为 nil）。
+// 每个 registry 使用各自仓库配置的 TLS 设置和凭据，未配置用户名的 registry 回退到 helm registry login 保存的凭据。
+// plain HTTP 对整个客户端生效，因此不能与 HTTPS registry 混用
+func newRegistriesClient(hosts map[string]*repo.Entry) (*registry.Client, error) {
+	transports := make(hostTransport, len(hosts))
+	creds := make(map[string]auth.Credential)
+	plainHTTP := 0
+	for host, entry := range hosts {
+		tlsConfig, err := repoTLSConfig(entry)
+		if err != nil {
+			return nil, err
+		}
+		transports[host] = &http.Transport{
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		}
+		if entry != nil && entry.Username != "" {
+			creds[host] = auth.Credential{Username: entry.Username, Password: entry.Password}
+		}
+		if isPlainHTTPRegistry(host) {
+			plainHTTP++
+		}
+	}
+	if plainHTTP > 0 && plainHTTP < len(hosts) {
+		return nil, fmt.Errorf("plain HTTP and HTTPS registries cannot be used together")
+	}
+
+	var transport http.RoundTripper = transports
+	if len(transports) == 1 {
+		// 只有一个 registry 时，token 服务等其他地址也沿用它的 TLS 设置
+		for _, t := range transports {
+			transport = t
+		}
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	opts := []registry.ClientOption{
+		registry.ClientOptEnableCache(true),
+		registry.ClientOptCredentialsFile(helmClient.settings.RegistryConfig),
+		registry.ClientOptHTTPClient(httpClient),
+	}
+	if plainHTTP > 0 {
+		opts = append(opts, registry.ClientOptPlainHTTP())
+	}
+	if len(creds) > 0 {
+		opts = append(opts, registry.ClientOptAuthorizer(auth.Client{
+			Client:     httpClient,
+			Cache:      auth.NewCache(),
+			Credential: registryCredential(creds, loginCredential()),
+		}))
+	}
+	return registry.NewClient(opts...)
+}
+
+// hostTransport 按请求的 registry 地址选择 Transport，未知地址使用默认 Transport
+type hostTransport map[string]*http.Transport
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if transport, ok := t[req.URL.Host]; ok {
+		return transport.RoundTrip(req)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// registryCredential 返回按 registry 地址查找凭据的函数，creds 中没有的地址使用 fallback
+func registryCredential(creds map[string]auth.Credential, fallback auth.CredentialFunc) auth.CredentialFunc {
+	return func(ctx context.Context, hostport string) (auth.Credential, error) {
+		if cred, ok := creds[hostport]; ok {
+			return cred, nil
+		}
+		if fallback == nil {
+			return auth.EmptyCredential, nil
+		}
+		return fallback(ctx, hostport)
+	}
+}
+
+// loginCredential 返回读取 helm registry login 所保存凭据的函数，凭据文件不可用时返回 nil
+func loginCredential() auth.CredentialFunc {
+	store, err := credentials.NewStore(helmClient.settings.RegistryConfig, credentials.StoreOptions{
+		DetectDefaultNativeStore: true,
+	})
+	if err != nil {
+		logger.L().Warn("Failed to load registry credentials", zap.Error(err))
+		return nil
+	}
+	return credentials.Credential(store)
+}
+
+// resolveChartRef 把请求中的 chart 名称和仓库转换为 LocateChart 可用的引用。
+// chartName 本身是 oci:// 引用时直接使用；OCI 仓库拼接为 oci://<registry>/<project>/<chart>，
+// 此时同时返回对应的 registry client；普通仓库先刷新索引，返回 <repo>/<chart>
+func resolveChartRef(repoName, chartName string) (string, *registry.Client, error) {
+	ref := chartName
+	if !registry.IsOCI(chartName) {
+		entry, err := getRepoEntry(repoName)
+		if err != nil {
+			return "", nil, err
+		}
+		if !registry.IsOCI(entry.URL) {
+			if err := refreshChartRepository(entry); err != nil {
+				logger.L().Error("Failed to refresh chart repository", zap.Error(err))
+				return "", nil, err
+			}
+			return fmt.Sprintf("%s/%s", entry.Name, chartName), nil, nil
+		}
+		ref = fmt.Sprintf("%s/%s", strings.TrimSuffix(entry.URL, "/"), chartName)
+	}
+
+	host := ociHost(ref)
+	client, err := newRegistryClient(host, registryEntryForHost(host))
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "create registry client failed: %v", err)
+	}
+	return ref, client, nil
+}
+
+// loginRegistry 校验 OCI 仓库的凭据，成功后凭据同时写入 helm 的 registry 配置
+func loginRegistry(entry *repo.Entry) error {
+	host := ociHost(entry.URL)
+	client, err := newRegistryClient(host, nil)
+	if err != nil {
+		return err
+	}
+	return client.Login(host,
+		registry.LoginOptBasicAuth(entry.Username, entry.Password),
+		registry.LoginOptInsecure(entry.InsecureSkipTLSverify),
+		registry.LoginOptTLSClientConfig(entry.CertFile, entry.KeyFile, entry.CAFile),
+		registry.LoginOptPlainText(isPlainHTTPRegistry(host)),
+	)
+}
+
+// chartPushTarget 上传 chart 的目标 OCI 地址：优先使用 CHART_PUSH_REGISTRY，
+// 默认仓库本身是 OCI 时直接使用，否则使用 harbor 对应项目的 OCI 地址
+func chartPushTarget(project string) (string, error) {
+	if target := os.Getenv("CHART_PUSH_REGISTRY"); target != "" {
+		if !registry.IsOCI(target) {
+			return "", fmt.Errorf("CHART_PUSH_REGISTRY must be an oci:// reference, got %q", target)
+		}
+		return strings.TrimSuffix(target, "/"), nil
+	}
+	harbor := defaultHarborEntry()
+	if registry.IsOCI(harbor.URL) {
+		return strings.TrimSuffix(harbor.URL, "/"), nil
+	}
+	u, err := url.Parse(harbor.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid harbor url %q: %w", harbor.URL, err)
+	}
+	return fmt.Sprintf("%s://%s/%s", registry.OCIScheme, u.Host, project), nil
+}
+
+// PushChartToHarbor 通过 Helm registry client 把 chart 包以 OCI 格式推送到 Harbor，
+// 返回推送后带版本的 oci:// 引用
+func PushChartToHarbor(filePath, repoName, fileName string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read chart file: %v", err)
+	}
+	chart, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to load chart archive: %v", err)
+	}
+
+	target, err := chartPushTarget(repoName)
+	if err != nil {
+		return "", err
+	}
+	host := ociHost(target)
+	client, err := newRegistryClient(host, registryEntryForHost(host))
+	if err != nil {
+		return "", fmt.Errorf("failed to create registry client: %v", err)
+	}
+
+	// OCI tag 不允许 '+'，与 helm push 一致由 registry client 转换为 '_'
+	ref := fmt.Sprintf("%s/%s:%s", target, chart.Metadata.Name, chart.Metadata.Version)
+	result, err := client.Push(data, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to push chart: %v", err)
+	}
+
+	logger.L().Info("Chart pushed to registry successfully",
+		zap.String("fileName", fileName),
+		zap.String("ref", result.Ref),
+		zap.String("digest", result.Manifest.Digest))
+
+	// result.Ref 是实际推送的引用（tag 中的 '+' 已转换），不含 oci:// 前缀
+	return fmt.Sprintf("%s://%s", registry.OCIScheme, result.Ref), nil
+}
+
+func (s *HelmManagerServer) ListChartTags(ctx context.Context, req *pb.ListChartTagsRequest) (*pb.ListChartTagsResponse, error) {
+	logger.L().Info("ListChartTags called", zap.String("request", req.String()))
+	if req.GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart_name is required")
+	}
+	entry, err := getRepoEntry(req.GetRepoName())
+	if err != nil {
+		return nil, err
+	}
+	if !registry.IsOCI(entry.URL) {
+		return nil, status.Errorf(codes.FailedPrecondition, "repository %q is not an OCI registry", entry.Name)
+	}
+
+	tags, err := ociChartTags(entry, req.GetChartName())
+	if err != nil {
+		logger.L().Error("Failed to list chart tags", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "list chart tags failed: %v", err)
+	}
+	return &pb.ListChartTagsResponse{
+		Code:    0,
+		Message: fmt.Sprintf("Found %d tags", len(tags)),
+		Success: true,
+		Tags:    tags,
+	}, nil
+}
+
+// ociChartTags 列出 OCI 仓库中 chart 的所有 semver tag，按版本从高到低排序
+func ociChartTags(entry *repo.Entry, chartName string) ([]string, error) {
+	host := ociHost(entry.URL)
+	client, err := newRegistryClient(host, entry)
+	if err != nil {
+		return nil, err
+	}
+	ref := fmt.Sprintf("%s/%s", strings.TrimSuffix(entry.URL, "/"), chartName)
+	return client.Tags(strings.TrimPrefix(ref, fmt.Sprintf("%s://", registry.OCIScheme)))
+}
@@ -0,0 +1,41 @@
+package helm
+
+import (
+	"context"
+	"testing"
+
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+func TestRegistryCredentialPerHost(t *testing.T) {
+	creds := map[string]auth.Credential{
+		"harbor.example.com":   {Username: "harbor", Password: "harbor-pass"},
+		"registry.example.com": {Username: "robot", Password: "robot-pass"},
+	}
+	fallback := func(_ context.Context, hostport string) (auth.Credential, error) {
+		return auth.Credential{Username: "login", Password: hostport}, nil
+	}
+
+	tests := []struct {
+		name     string
+		fallback auth.CredentialFunc
+		host     string
+		want     auth.Credential
+	}{
+		{name: "first registry", fallback: fallback, host: "harbor.example.com", want: creds["harbor.example.com"]},
+		{name: "second registry", fallback: fallback, host: "registry.example.com", want: creds["registry.example.com"]},
+		{name: "unconfigured registry uses login credentials", fallback: fallback, host: "ghcr.io", want: auth.Credential{Username: "login", Password: "ghcr.io"}},
+		{name: "unconfigured registry without login credentials", host: "ghcr.io", want: auth.EmptyCredential},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := registryCredential(creds, tt.fallback)(context.Background(), tt.host)
+			if err != nil {
+				t.Fatalf("credential(%q) error = %v", tt.host, err)
+			}
+			if got != tt.want {
+				t.Errorf("credential(%q) = %+v, want %+v", tt.host, got, tt.want)
+			}
+		})
+	}
+}
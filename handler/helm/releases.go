@@ -0,0 +1,261 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	allNamespaces = "all"
+
+	releaseSortByName      = "name"
+	releaseSortByNamespace = "namespace"
+	releaseSortByChart     = "chart"
+	releaseSortByUpdated   = "updated"
+)
+
+// defaultReleaseStates 未指定状态时列出的 release：除已卸载（keep_history 保留的记录）和已被替代的版本外的所有状态
+const defaultReleaseStates = action.ListAll &^ (action.ListUninstalled | action.ListSuperseded)
+
+func (s *HelmManagerServer) ListInstalledCharts(ctx context.Context, req *pb.ListInstalledChartsRequest) (*pb.ListInstalledChartsResponse, error) {
+	logger.L().Info("ListInstalledCharts called", zap.String("request", req.String()))
+	if req.GetReleaseName() != "" {
+		if _, err := regexp.Compile(req.GetReleaseName()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid release_name regex %q: %v", req.GetReleaseName(), err)
+		}
+	}
+	stateMask, err := releaseStateMask(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	sortBy := req.GetSortBy()
+	switch sortBy {
+	case "", releaseSortByName, releaseSortByNamespace, releaseSortByChart, releaseSortByUpdated:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort_by %q, must be one of %q, %q, %q, %q",
+			sortBy, releaseSortByName, releaseSortByNamespace, releaseSortByChart, releaseSortByUpdated)
+	}
+	if req.GetOffset() < 0 || req.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == allNamespaces {
+		namespace = ""
+	}
+	releases, err := listReleases(ctx, namespace, func(list *action.List) {
+		list.Filter = req.GetReleaseName()
+		list.StateMask = stateMask
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 列出全部命名空间时只保留调用方有权访问的命名空间
+	var visible func(string) (bool, error)
+	if namespace == "" {
+		if visible, err = namespaceFilter(ctx, pb.HelmManagerService_ListInstalledCharts_FullMethodName); err != nil {
+			return nil, err
+		}
+	}
+
+	// chart 名称过滤、排序和分页在合并所有命名空间的结果后进行
+	chartName := req.GetChartName()
+	filtered := releases[:0]
+	for _, rel := range releases {
+		if chartName != "" && (rel.Chart == nil || rel.Chart.Metadata == nil || !strings.EqualFold(rel.Chart.Metadata.Name, chartName)) {
+			continue
+		}
+		if visible != nil {
+			ok, err := visible(rel.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		filtered = append(filtered, rel)
+	}
+	sortReleases(filtered, sortBy, req.GetDesc())
+
+	total := len(filtered)
+	start := int(req.GetOffset())
+	if start > total {
+		start = total
+	}
+	end := total
+	if req.GetLimit() > 0 && start+int(req.GetLimit()) < end {
+		end = start + int(req.GetLimit())
+	}
+
+	data := &pb.ListInstalledChartsData{
+		Total:    int32(total),
+		Offset:   req.GetOffset(),
+		Limit:    req.GetLimit(),
+		Releases: make([]*pb.InstalledChart, 0, end-start),
+	}
+	for _, rel := range filtered[start:end] {
+		data.Releases = append(data.Releases, toPbInstalledChart(rel, req.GetWithStatus(), req.GetWithManifest()))
+	}
+	return &pb.ListInstalledChartsResponse{
+		Code:    0,
+		Message: fmt.Sprintf("Found %d installed charts", total),
+		Success: true,
+		Data:    data,
+	}, nil
+}
+
+// releaseStateMask 将状态名称转换为 helm list 的状态掩码
+func releaseStateMask(statuses []string) (action.ListStates, error) {
+	if len(statuses) == 0 {
+		return defaultReleaseStates, nil
+	}
+	var mask action.ListStates
+	for _, s := range statuses {
+		state := mask.FromName(strings.ToLower(s))
+		if state == action.ListUnknown {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid status %q", s)
+		}
+		mask |= state
+	}
+	return mask, nil
+}
+
+// listReleases 列出指定命名空间的 release，namespace 为空时列出所有命名空间。
+// 没有集群范围权限时逐个命名空间查询，跳过没有权限的命名空间
+func listReleases(ctx context.Context, namespace string, configure func(*action.List)) ([]*release.Release, error) {
+	releases, err := runList(namespace, configure)
+	if err == nil || namespace != "" || !apierrors.IsForbidden(err) {
+		return releases, err
+	}
+
+	logger.L().Warn("Listing releases across all namespaces is forbidden, falling back to per-namespace listing", zap.Error(err))
+	clientset, err := kubeClientset()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create kubernetes client failed: %v", err)
+	}
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsForbidden(err) {
+			return nil, status.Errorf(codes.PermissionDenied, "list namespaces forbidden: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "list namespaces failed: %v", err)
+	}
+	releases = nil
+	for _, ns := range namespaces.Items {
+		rels, err := runList(ns.Name, configure)
+		if err != nil {
+			if apierrors.IsForbidden(err) {
+				continue
+			}
+			return nil, err
+		}
+		releases = append(releases, rels...)
+	}
+	return releases, nil
+}
+
+func runList(namespace string, configure func(*action.List)) ([]*release.Release, error) {
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration for list", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	list := action.NewList(actionConfig)
+	list.AllNamespaces = namespace == ""
+	configure(list)
+	releases, err := list.Run()
+	if err != nil {
+		if apierrors.IsForbidden(err) {
+			return nil, err
+		}
+		logger.L().Error("Failed to list installed charts", zap.String("namespace", namespace), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "list installed charts failed: %v", err)
+	}
+	return releases, nil
+}
+
+func sortReleases(releases []*release.Release, sortBy string, desc bool) {
+	less := func(a, b *release.Release) bool {
+		switch sortBy {
+		case releaseSortByNamespace:
+			if a.Namespace != b.Namespace {
+				return a.Namespace < b.Namespace
+			}
+		case releaseSortByChart:
+			if ca, cb := releaseChartName(a), releaseChartName(b); ca != cb {
+				return ca < cb
+			}
+		case releaseSortByUpdated:
+			if ta, tb := releaseUpdated(a), releaseUpdated(b); !ta.Equal(tb) {
+				return ta.Before(tb)
+			}
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Namespace < b.Namespace
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		if desc {
+			return less(releases[j], releases[i])
+		}
+		return less(releases[i], releases[j])
+	})
+}
+
+func releaseChartName(rel *release.Release) string {
+	if rel.Chart == nil || rel.Chart.Metadata == nil {
+		return ""
+	}
+	return rel.Chart.Metadata.Name
+}
+
+func releaseUpdated(rel *release.Release) time.Time {
+	if rel.Info == nil {
+		return time.Time{}
+	}
+	return rel.Info.LastDeployed.Time
+}
+
+func toPbInstalledChart(rel *release.Release, withStatus, withManifest bool) *pb.InstalledChart {
+	info := &pb.InstalledChart{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  int32(rel.Version),
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		info.ChartName = rel.Chart.Metadata.Name
+		info.ChartVersion = rel.Chart.Metadata.Version
+		info.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+	if rel.Info != nil {
+		info.Status = rel.Info.Status.String()
+		if !rel.Info.LastDeployed.IsZero() {
+			info.Updated = timestamppb.New(rel.Info.LastDeployed.Time)
+		}
+		if withStatus {
+			info.Description = rel.Info.Description
+		}
+	}
+	if withManifest {
+		info.Manifest = rel.Manifest
+	}
+	return info
+}
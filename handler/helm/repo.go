@@ -0,0 +1,347 @@
+package helm
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// repoMu 保护 repositories.yaml 的读改写
+var repoMu sync.Mutex
+
+// harborMu 保护 harborEntry。持有 repoMu 时也会读取默认仓库，因此使用单独的锁
+var harborMu sync.RWMutex
+
+// defaultHarborEntry 返回默认 harbor 仓库配置的副本
+func defaultHarborEntry() repo.Entry {
+	harborMu.RLock()
+	defer harborMu.RUnlock()
+	return harborEntry
+}
+
+func setHarborEntry(entry repo.Entry) {
+	harborMu.Lock()
+	defer harborMu.Unlock()
+	harborEntry = entry
+}
+
+// loadRepoFile 读取 HelmClient 使用的 repositories.yaml，文件不存在时返回空配置
+func loadRepoFile() (*repo.File, error) {
+	repoFile, err := repo.LoadFile(helmClient.settings.RepositoryConfig)
+	if os.IsNotExist(err) {
+		return repo.NewFile(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load repository file: %w", err)
+	}
+	return repoFile, nil
+}
+
+// getRepoEntry 按名称查找仓库，name 为空时使用默认仓库
+func getRepoEntry(name string) (*repo.Entry, error) {
+	if name == "" {
+		name = defaultRepoName()
+	}
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	entry := repoFile.Get(name)
+	if entry == nil {
+		return nil, status.Errorf(codes.NotFound, "repository %q not found", name)
+	}
+	return entry, nil
+}
+
+// newChartRepository 创建使用 HelmClient 缓存目录的 ChartRepository
+func newChartRepository(entry *repo.Entry) (*repo.ChartRepository, error) {
+	chartRepo, err := repo.NewChartRepository(entry, getter.All(helmClient.settings))
+	if err != nil {
+		return nil, err
+	}
+	chartRepo.CachePath = helmClient.settings.RepositoryCache
+	return chartRepo, nil
+}
+
+// repoTLSConfig 根据仓库配置的证书和 TLS 选项构造 tls.Config，entry 为 nil 时使用默认配置
+func repoTLSConfig(entry *repo.Entry) (*tls.Config, error) {
+	cfg := &tls.Config{}
+	if entry == nil {
+		return cfg, nil
+	}
+	cfg.InsecureSkipVerify = entry.InsecureSkipTLSverify
+	if entry.CertFile != "" && entry.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(entry.CertFile, entry.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if entry.CAFile != "" {
+		data, err := os.ReadFile(entry.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificate found in %s", entry.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// repoCertFile 仓库 CA 证书在服务端的保存路径
+func repoCertFile(name string) string {
+	return filepath.Join(repoCertsDir(), name+"-ca.crt")
+}
+
+// repoCertsDir 保存仓库 CA 证书的目录，与 repositories.yaml 同级
+func repoCertsDir() string {
+	return filepath.Join(filepath.Dir(helmClient.settings.RepositoryConfig), "certs")
+}
+
+// resolveCAFile 校验 ca_file 中的服务端路径，只允许引用 certs 目录或 REPO_CA_DIR 下的文件，
+// 防止通过仓库配置读取服务端的任意文件。符号链接解析后再判断
+func resolveCAFile(path string) (string, error) {
+	dirs := []string{repoCertsDir()}
+	if dir := os.Getenv("REPO_CA_DIR"); dir != "" {
+		dirs = append(dirs, dir)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		resolved, err = filepath.Abs(resolved)
+	}
+	if err != nil || !fileExists(resolved) {
+		return "", status.Errorf(codes.InvalidArgument, "ca file %q does not exist", path)
+	}
+	for _, dir := range dirs {
+		if d, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = d
+		}
+		if dir, err = filepath.Abs(dir); err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(dir, resolved)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return resolved, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "ca file must be PEM content or a file under %s", strings.Join(dirs, ", "))
+}
+
+func (s *HelmManagerServer) ConfigureRepo(ctx context.Context, req *pb.ConfigureRepoRequest) (*pb.ConfigureRepoResponse, error) {
+	logger.L().Info("ConfigureRepo called", zap.String("name", req.GetName()), zap.String("url", req.GetUrl()))
+	name := req.GetName()
+	if name == "" || req.GetUrl() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name and url are required")
+	}
+	if strings.Contains(name, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "repository name %q must not contain '/'", name)
+	}
+
+	repoMu.Lock()
+	defer repoMu.Unlock()
+
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	existing := repoFile.Get(name)
+
+	entry := &repo.Entry{
+		Name:                  name,
+		URL:                   req.GetUrl(),
+		Username:              req.GetUsername(),
+		Password:              req.GetPassword(),
+		InsecureSkipTLSverify: req.GetInsecureSkipTlsVerify(),
+		PassCredentialsAll:    req.GetPassCredentialsAll(),
+	}
+	// 列表接口不返回密码，更新时未填写密码则沿用原来的
+	if entry.Password == "" && existing != nil && existing.Username == entry.Username {
+		entry.Password = existing.Password
+	}
+
+	// CA 证书既可以是 PEM 内容，也可以是服务端 certs 目录下已有的文件路径；
+	// PEM 内容先写入临时文件，仓库校验通过后再替换正式文件
+	var tempCAFile string
+	if ca := strings.TrimSpace(req.GetCaFile()); ca != "" {
+		if strings.HasPrefix(ca, "-----BEGIN") {
+			tempCAFile, err = writeTempCAFile(name, ca)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "save ca file failed: %v", err)
+			}
+			defer os.Remove(tempCAFile)
+			entry.CAFile = tempCAFile
+		} else {
+			if entry.CAFile, err = resolveCAFile(ca); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := validateRepo(entry); err != nil {
+		logger.L().Error("Failed to validate chart repository", zap.String("repository", name), zap.Error(err))
+		return nil, status.Errorf(codes.FailedPrecondition, "%q is not a valid chart repository or cannot be reached: %v", req.GetUrl(), err)
+	}
+
+	if tempCAFile != "" {
+		certFile := repoCertFile(name)
+		if err := os.Rename(tempCAFile, certFile); err != nil {
+			return nil, status.Errorf(codes.Internal, "save ca file failed: %v", err)
+		}
+		entry.CAFile = certFile
+	}
+
+	repoFile.Update(entry)
+	if err := repoFile.WriteFile(helmClient.settings.RepositoryConfig, 0644); err != nil {
+		logger.L().Error("Failed to write repository file", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "write repository file failed: %v", err)
+	}
+	repoIndexes.Invalidate(name)
+	if name == defaultHarborEntry().Name {
+		setHarborEntry(*entry)
+	}
+
+	message := "Repository added successfully"
+	if existing != nil {
+		message = "Repository updated successfully"
+	}
+	logger.L().Info(message, zap.String("name", name), zap.String("url", entry.URL))
+	return &pb.ConfigureRepoResponse{
+		Success: true,
+		Code:    0,
+		Message: message,
+		Data:    toPbRepoInfo(entry),
+	}, nil
+}
+
+// validateRepo 与 helm repo add 一致，先下载 index.yaml 确认仓库可用；
+// OCI 仓库没有索引，配置了用户名时通过登录校验凭据
+func validateRepo(entry *repo.Entry) error {
+	if registry.IsOCI(entry.URL) {
+		if entry.Username == "" {
+			return nil
+		}
+		return loginRegistry(entry)
+	}
+	chartRepo, err := newChartRepository(entry)
+	if err != nil {
+		return err
+	}
+	_, err = chartRepo.DownloadIndexFile()
+	return err
+}
+
+func writeTempCAFile(name, content string) (string, error) {
+	dir := filepath.Dir(repoCertFile(name))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp(dir, name+"-ca-*.crt")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content + "\n"); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func (s *HelmManagerServer) ListRepos(ctx context.Context, req *pb.ListReposRequest) (*pb.ListReposResponse, error) {
+	logger.L().Info("ListRepos called")
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	repos := make([]*pb.RepoInfo, 0, len(repoFile.Repositories))
+	for _, entry := range repoFile.Repositories {
+		repos = append(repos, toPbRepoInfo(entry))
+	}
+	return &pb.ListReposResponse{
+		Code:    0,
+		Message: fmt.Sprintf("Found %d repositories", len(repos)),
+		Success: true,
+		Data:    repos,
+	}, nil
+}
+
+func (s *HelmManagerServer) RemoveRepo(ctx context.Context, req *pb.RemoveRepoRequest) (*pb.RemoveRepoResponse, error) {
+	logger.L().Info("RemoveRepo called", zap.String("name", req.GetName()))
+	name := req.GetName()
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if name == defaultHarborEntry().Name || (localRepo != nil && name == localRepoName) {
+		return nil, status.Errorf(codes.FailedPrecondition, "default repository %q cannot be removed", name)
+	}
+
+	repoMu.Lock()
+	defer repoMu.Unlock()
+
+	repoFile, err := loadRepoFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	entry := repoFile.Get(name)
+	if entry == nil {
+		return nil, status.Errorf(codes.NotFound, "repository %q not found", name)
+	}
+	repoFile.Remove(name)
+	if err := repoFile.WriteFile(helmClient.settings.RepositoryConfig, 0644); err != nil {
+		logger.L().Error("Failed to write repository file", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "write repository file failed: %v", err)
+	}
+	repoIndexes.Invalidate(name)
+
+	// 清理缓存的索引文件和服务端保存的 CA 证书
+	cacheDir := helmClient.settings.RepositoryCache
+	for _, f := range []string{
+		filepath.Join(cacheDir, helmpath.CacheIndexFile(name)),
+		filepath.Join(cacheDir, helmpath.CacheChartsFile(name)),
+	} {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			logger.L().Warn("Failed to remove repository cache", zap.String("file", f), zap.Error(err))
+		}
+	}
+	if entry.CAFile == repoCertFile(name) {
+		os.Remove(entry.CAFile)
+	}
+
+	logger.L().Info("Repository removed", zap.String("name", name))
+	return &pb.RemoveRepoResponse{
+		Code:    0,
+		Message: "Repository removed successfully",
+		Success: true,
+	}, nil
+}
+
+func toPbRepoInfo(entry *repo.Entry) *pb.RepoInfo {
+	return &pb.RepoInfo{
+		Name:                  entry.Name,
+		Url:                   entry.URL,
+		Username:              entry.Username,
+		CaFile:                entry.CAFile,
+		InsecureSkipTlsVerify: entry.InsecureSkipTLSverify,
+		PassCredentialsAll:    entry.PassCredentialsAll,
+	}
+}
@@ -0,0 +1,49 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveCAFile(t *testing.T) {
+	caDir := t.TempDir()
+	outside := t.TempDir()
+	t.Setenv("REPO_CA_DIR", caDir)
+
+	allowed := filepath.Join(caDir, "harbor-ca.crt")
+	secret := filepath.Join(outside, "id_rsa")
+	for _, path := range []string{allowed, secret} {
+		if err := os.WriteFile(path, []byte("data"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := filepath.Join(caDir, "link.crt")
+	if err := os.Symlink(secret, link); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want codes.Code
+	}{
+		{name: "file in REPO_CA_DIR", path: allowed, want: codes.OK},
+		{name: "file outside the certs directories", path: secret, want: codes.InvalidArgument},
+		{name: "path traversal", path: filepath.Join(caDir, "..", filepath.Base(outside), "id_rsa"), want: codes.InvalidArgument},
+		{name: "symlink pointing outside", path: link, want: codes.InvalidArgument},
+		{name: "directory itself", path: caDir, want: codes.InvalidArgument},
+		{name: "missing file", path: filepath.Join(caDir, "missing.crt"), want: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveCAFile(tt.path)
+			if status.Code(err) != tt.want {
+				t.Fatalf("resolveCAFile(%q) = %q, %v, want %v", tt.path, got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	healthHealthy     = "Healthy"
+	healthProgressing = "Progressing"
+	healthDegraded    = "Degraded"
+	healthMissing     = "Missing"
+	healthUnknown     = "Unknown"
+)
+
+// healthRank 汇总 release 健康状态时取最差的一个
+var healthRank = map[string]int{
+	healthHealthy:     0,
+	healthProgressing: 1,
+	healthUnknown:     2,
+	healthMissing:     3,
+	healthDegraded:    4,
+}
+
+func (s *HelmManagerServer) GetReleaseResources(ctx context.Context, req *pb.GetReleaseResourcesRequest) (*pb.GetReleaseResourcesResponse, error) {
+	logger.L().Info("GetReleaseResources called", zap.String("request", req.String()))
+	if req.GetReleaseName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "release_name is required")
+	}
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	rel, err := action.NewGet(actionConfig).Run(req.GetReleaseName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "release %q not found in namespace %q: %v", req.GetReleaseName(), namespace, err)
+	}
+	clientset, err := kubeClientset()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create k8s clientset: %v", err)
+	}
+
+	tree := &resourceTree{ctx: ctx, clientset: clientset, namespace: namespace}
+	data := &pb.ReleaseResources{
+		ReleaseName: rel.Name,
+		Namespace:   rel.Namespace,
+		Revision:    int32(rel.Version),
+		Status:      rel.Info.Status.String(),
+		Health:      healthHealthy,
+	}
+	for _, doc := range splitManifests(rel.Manifest) {
+		var content map[string]any
+		if err := yaml.Unmarshal([]byte(doc), &content); err != nil || len(content) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: content}
+		node := &pb.ResourceNode{
+			ApiVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+			Namespace:  obj.GetNamespace(),
+		}
+		tree.fill(actionConfig, node, doc)
+		if healthRank[node.Health] > healthRank[data.Health] {
+			data.Health = node.Health
+		}
+		data.Resources = append(data.Resources, node)
+	}
+
+	return &pb.GetReleaseResourcesResponse{
+		Code:    0,
+		Message: fmt.Sprintf("Found %d resources", len(data.Resources)),
+		Success: true,
+		Data:    data,
+	}, nil
+}
+
+// resourceTree 查询资源的实际状态，namespace 下的 Pod 和 ReplicaSet 只在需要时查询一次
+type resourceTree struct {
+	ctx       context.Context
+	clientset kubernetes.Interface
+	namespace string
+
+	pods        []v1.Pod
+	podsErr     error
+	podsLoaded  bool
+	rsOwners    map[types.UID]types.UID // ReplicaSet UID -> Deployment UID
+	rsOwnersErr error
+}
+
+// fill 查询单个资源的实际状态并填充健康信息
+func (t *resourceTree) fill(actionConfig *action.Configuration, node *pb.ResourceNode, doc string) {
+	infos, err := actionConfig.KubeClient.Build(strings.NewReader(doc), false)
+	if err != nil || len(infos) == 0 {
+		node.Health, node.Message = healthUnknown, fmt.Sprintf("resource type not recognized by cluster: %v", err)
+		return
+	}
+	info := infos[0]
+	node.Namespace = info.Namespace
+	if err := info.Get(); err != nil {
+		if apierrors.IsNotFound(err) {
+			node.Health, node.Message = healthMissing, "resource not found in cluster"
+		} else {
+			node.Health, node.Message = healthUnknown, err.Error()
+		}
+		return
+	}
+	live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+	if err != nil {
+		node.Health, node.Message = healthUnknown, err.Error()
+		return
+	}
+
+	switch node.Kind {
+	case "Deployment":
+		var d appsv1.Deployment
+		if convertLive(node, live, &d) {
+			t.fillDeployment(node, &d)
+		}
+	case "StatefulSet":
+		var sts appsv1.StatefulSet
+		if convertLive(node, live, &sts) {
+			t.fillStatefulSet(node, &sts)
+		}
+	case "DaemonSet":
+		var ds appsv1.DaemonSet
+		if convertLive(node, live, &ds) {
+			t.fillDaemonSet(node, &ds)
+		}
+	case "Job":
+		var job batchv1.Job
+		if convertLive(node, live, &job) {
+			t.fillJob(node, &job)
+		}
+	case "Service":
+		var svc v1.Service
+		if convertLive(node, live, &svc) {
+			t.fillService(node, &svc)
+		}
+	case "Ingress":
+		var ing networkingv1.Ingress
+		if convertLive(node, live, &ing) {
+			fillIngress(node, &ing)
+		}
+	case "PersistentVolumeClaim":
+		var pvc v1.PersistentVolumeClaim
+		if convertLive(node, live, &pvc) {
+			fillPVC(node, &pvc)
+		}
+	case "Pod":
+		var pod v1.Pod
+		if convertLive(node, live, &pod) {
+			node.Pod = toPbPodStatus(&pod)
+			node.Health, node.Message = podHealth(&pod)
+		}
+	default:
+		node.Health = healthHealthy
+	}
+}
+
+func convertLive(node *pb.ResourceNode, live map[string]any, out any) bool {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(live, out); err != nil {
+		node.Health, node.Message = healthUnknown, err.Error()
+		return false
+	}
+	return true
+}
+
+func (t *resourceTree) fillDeployment(node *pb.ResourceNode, d *appsv1.Deployment) {
+	desired := replicas(d.Spec.Replicas)
+	node.Ready = fmt.Sprintf("%d/%d", d.Status.ReadyReplicas, desired)
+	node.Health, node.Message = healthHealthy, fmt.Sprintf("%d/%d replicas ready", d.Status.ReadyReplicas, desired)
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Status == v1.ConditionFalse ||
+			c.Type == appsv1.DeploymentReplicaFailure && c.Status == v1.ConditionTrue {
+			node.Health, node.Message = healthDegraded, c.Message
+		}
+	}
+	if node.Health != healthDegraded && (d.Status.ObservedGeneration < d.Generation ||
+		d.Status.UpdatedReplicas < desired || d.Status.AvailableReplicas < desired) {
+		node.Health = healthProgressing
+	}
+	node.Children = t.podNodes(d.UID, true)
+}
+
+func (t *resourceTree) fillStatefulSet(node *pb.ResourceNode, sts *appsv1.StatefulSet) {
+	desired := replicas(sts.Spec.Replicas)
+	node.Ready = fmt.Sprintf("%d/%d", sts.Status.ReadyReplicas, desired)
+	node.Health, node.Message = healthHealthy, fmt.Sprintf("%d/%d replicas ready", sts.Status.ReadyReplicas, desired)
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.ReadyReplicas < desired ||
+		sts.Status.UpdateRevision != "" && sts.Status.CurrentRevision != sts.Status.UpdateRevision {
+		node.Health = healthProgressing
+	}
+	node.Children = t.podNodes(sts.UID, false)
+}
+
+func (t *resourceTree) fillDaemonSet(node *pb.ResourceNode, ds *appsv1.DaemonSet) {
+	desired := ds.Status.DesiredNumberScheduled
+	node.Ready = fmt.Sprintf("%d/%d", ds.Status.NumberReady, desired)
+	node.Health, node.Message = healthHealthy, fmt.Sprintf("%d/%d pods ready", ds.Status.NumberReady, desired)
+	if ds.Status.ObservedGeneration < ds.Generation || ds.Status.NumberReady < desired || ds.Status.UpdatedNumberScheduled < desired {
+		node.Health = healthProgressing
+	}
+	node.Children = t.podNodes(ds.UID, false)
+}
+
+func (t *resourceTree) fillJob(node *pb.ResourceNode, job *batchv1.Job) {
+	completions := replicas(job.Spec.Completions)
+	node.Ready = fmt.Sprintf("%d/%d", job.Status.Succeeded, completions)
+	node.Health, node.Message = healthProgressing, fmt.Sprintf("%d/%d completions", job.Status.Succeeded, completions)
+	for _, c := range job.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			node.Health = healthHealthy
+		case batchv1.JobFailed:
+			node.Health, node.Message = healthDegraded, c.Message
+		}
+	}
+	node.Children = t.podNodes(job.UID, false)
+}
+
+func (t *resourceTree) fillService(node *pb.ResourceNode, svc *v1.Service) {
+	node.Health = healthHealthy
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		node.Message = "external name " + svc.Spec.ExternalName
+		return
+	}
+	for _, lb := range svc.Status.LoadBalancer.Ingress {
+		node.LoadBalancer = append(node.LoadBalancer, firstNonEmpty(lb.IP, lb.Hostname))
+	}
+	if svc.Spec.Type == v1.ServiceTypeLoadBalancer && len(node.LoadBalancer) == 0 {
+		node.Health, node.Message = healthProgressing, "waiting for load balancer"
+	}
+
+	slices, err := t.clientset.DiscoveryV1().EndpointSlices(svc.Namespace).List(t.ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + svc.Name,
+	})
+	if err != nil {
+		logger.L().Warn("Failed to list endpoint slices", zap.String("service", svc.Name), zap.Error(err))
+		return
+	}
+	for _, slice := range slices.Items {
+		for _, ep := range slice.Endpoints {
+			if ep.Conditions.Ready != nil && !*ep.Conditions.Ready {
+				continue
+			}
+			for _, addr := range ep.Addresses {
+				for _, port := range slice.Ports {
+					if port.Port != nil {
+						node.Endpoints = append(node.Endpoints, net.JoinHostPort(addr, strconv.Itoa(int(*port.Port))))
+					}
+				}
+			}
+		}
+	}
+	if len(svc.Spec.Selector) > 0 && len(node.Endpoints) == 0 {
+		node.Health, node.Message = healthDegraded, "no ready endpoints"
+	}
+}
+
+func fillIngress(node *pb.ResourceNode, ing *networkingv1.Ingress) {
+	for _, rule := range ing.Spec.Rules {
+		if rule.Host != "" {
+			node.Hosts = append(node.Hosts, rule.Host)
+		}
+	}
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		node.LoadBalancer = append(node.LoadBalancer, firstNonEmpty(lb.IP, lb.Hostname))
+	}
+	node.Health = healthHealthy
+	if len(node.LoadBalancer) == 0 {
+		node.Health, node.Message = healthProgressing, "waiting for address"
+	}
+}
+
+func fillPVC(node *pb.ResourceNode, pvc *v1.PersistentVolumeClaim) {
+	node.Phase = string(pvc.Status.Phase)
+	switch pvc.Status.Phase {
+	case v1.ClaimBound:
+		node.Health, node.Message = healthHealthy, "bound to "+pvc.Spec.VolumeName
+	case v1.ClaimLost:
+		node.Health, node.Message = healthDegraded, "bound volume is lost"
+	default:
+		node.Health, node.Message = healthProgressing, "waiting for volume"
+	}
+}
+
+// podNodes 返回控制器管理的 Pod。Deployment 的 Pod 属于它的 ReplicaSet，需要再向上查一层
+func (t *resourceTree) podNodes(ownerUID types.UID, viaReplicaSet bool) []*pb.ResourceNode {
+	pods, err := t.listPods()
+	if err != nil {
+		logger.L().Warn("Failed to list pods", zap.String("namespace", t.namespace), zap.Error(err))
+		return nil
+	}
+	var rsOwners map[types.UID]types.UID
+	if viaReplicaSet {
+		if rsOwners, err = t.replicaSetOwners(); err != nil {
+			logger.L().Warn("Failed to list replicasets", zap.String("namespace", t.namespace), zap.Error(err))
+			return nil
+		}
+	}
+
+	var nodes []*pb.ResourceNode
+	for i := range pods {
+		pod := &pods[i]
+		ref := metav1.GetControllerOf(pod)
+		if ref == nil {
+			continue
+		}
+		owner := ref.UID
+		if viaReplicaSet {
+			owner = rsOwners[ref.UID]
+		}
+		if owner != ownerUID {
+			continue
+		}
+		health, message := podHealth(pod)
+		nodes = append(nodes, &pb.ResourceNode{
+			ApiVersion: "v1",
+			Kind:       "Pod",
+			Name:       pod.Name,
+			Namespace:  pod.Namespace,
+			Health:     health,
+			Message:    message,
+			Phase:      string(pod.Status.Phase),
+			Pod:        toPbPodStatus(pod),
+		})
+	}
+	return nodes
+}
+
+func (t *resourceTree) listPods() ([]v1.Pod, error) {
+	if !t.podsLoaded {
+		t.podsLoaded = true
+		list, err := t.clientset.CoreV1().Pods(t.namespace).List(t.ctx, metav1.ListOptions{})
+		if err != nil {
+			t.podsErr = err
+		} else {
+			t.pods = list.Items
+		}
+	}
+	return t.pods, t.podsErr
+}
+
+func (t *resourceTree) replicaSetOwners() (map[types.UID]types.UID, error) {
+	if t.rsOwners == nil && t.rsOwnersErr == nil {
+		list, err := t.clientset.AppsV1().ReplicaSets(t.namespace).List(t.ctx, metav1.ListOptions{})
+		if err != nil {
+			t.rsOwnersErr = err
+			return nil, err
+		}
+		t.rsOwners = make(map[types.UID]types.UID, len(list.Items))
+		for i := range list.Items {
+			if ref := metav1.GetControllerOf(&list.Items[i]); ref != nil {
+				t.rsOwners[list.Items[i].UID] = ref.UID
+			}
+		}
+	}
+	return t.rsOwners, t.rsOwnersErr
+}
+
+// podHealth 根据 calculatePodStatus 的结果和容器就绪情况判断 Pod 健康状态
+func podHealth(pod *v1.Pod) (string, string) {
+	podStatus := calculatePodStatus(pod)
+	switch pod.Status.Phase {
+	case v1.PodSucceeded:
+		return healthHealthy, podStatus
+	case v1.PodFailed:
+		return healthDegraded, podStatus
+	}
+	if podStatus != string(pod.Status.Phase) {
+		// 容器处于 Waiting 或异常退出，ContainerCreating/PodInitializing 属于正常启动过程
+		if podStatus == "ContainerCreating" || podStatus == "PodInitializing" {
+			return healthProgressing, podStatus
+		}
+		return healthDegraded, podStatus
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		return healthProgressing, podStatus
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		if !c.Ready {
+			return healthProgressing, "containers not ready"
+		}
+	}
+	return healthHealthy, podStatus
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
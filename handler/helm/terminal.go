@@ -0,0 +1,78 @@
+package helm
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/terminal"
+)
+
+const (
+	// 浏览器访问终端的外部地址前缀（如 wss://jos.example.com），为空时返回相对路径
+	terminalBaseURLEnv = "TERMINAL_WS_BASE_URL"
+	shellProbeTimeout  = 10 * time.Second
+)
+
+// CheckPodTerminal 检查容器是否有可用的 shell，并返回浏览器终端的 WebSocket 地址
+func (s *HelmManagerServer) CheckPodTerminal(ctx context.Context, req *pb.CheckPodTerminalRequest) (*pb.CheckPodTerminalResponse, error) {
+	logger.L().Info("CheckPodTerminal called", zap.String("request", req.String()))
+	if req.GetNamespace() == "" || req.GetPodName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace and pod_name are required")
+	}
+
+	term, err := terminal.New()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create terminal failed: %v", err)
+	}
+
+	pod, err := term.Clientset.CoreV1().Pods(req.GetNamespace()).Get(ctx, req.GetPodName(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "pod %s/%s not found", req.GetNamespace(), req.GetPodName())
+		}
+		return nil, status.Errorf(codes.Internal, "get pod failed: %v", err)
+	}
+	if !containerRunning(pod, req.GetContainer()) {
+		return &pb.CheckPodTerminalResponse{Supported: false}, nil
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, shellProbeTimeout)
+	defer cancel()
+	supported, err := term.HasShell(probeCtx, req.GetNamespace(), req.GetPodName(), req.GetContainer())
+	if err != nil {
+		// 镜像中没有 /bin/sh 时 exec 本身会失败，按不支持处理
+		logger.L().Info("Shell probe failed", zap.String("pod", req.GetPodName()), zap.Error(err))
+		supported = false
+	}
+
+	resp := &pb.CheckPodTerminalResponse{Supported: supported}
+	if supported {
+		resp.WebsocketUrl = strings.TrimSuffix(os.Getenv(terminalBaseURLEnv), "/") +
+			terminal.WebSocketPath(req.GetNamespace(), req.GetPodName(), req.GetContainer())
+	}
+	return resp, nil
+}
+
+// containerRunning 判断目标容器（未指定时为任意容器）是否处于运行状态
+func containerRunning(pod *v1.Pod, container string) bool {
+	if pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if (container == "" || cs.Name == container) && cs.State.Running != nil {
+			return true
+		}
+	}
+	return false
+}
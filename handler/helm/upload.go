@@ -0,0 +1,534 @@
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/lint/support"
+	"helm.sh/helm/v3/pkg/provenance"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	// defaultMaxChartSize 上传 chart 包（压缩后）的默认大小上限
+	defaultMaxChartSize = 10 << 20
+	// maxProvenanceSize .prov 签名文件的大小上限
+	maxProvenanceSize = 1 << 20
+	// defaultUploadTTL 未完成的流式上传保留时间，超时后清理
+	defaultUploadTTL = 24 * time.Hour
+	// defaultUploadRepo 未指定时推送的目标项目
+	defaultUploadRepo = "library"
+
+	// UploadChart 响应头：上传ID和服务端已接收的字节数
+	uploadIDHeader     = "upload-id"
+	uploadOffsetHeader = "upload-offset"
+)
+
+// activeUploads 正在接收分片的 upload_id，同一个上传同时只能有一个流
+var activeUploads sync.Map
+
+// lintSeverities 与 helm lint 输出的级别名称一致
+var lintSeverities = map[int]string{
+	support.UnknownSev: "UNKNOWN",
+	support.InfoSev:    "INFO",
+	support.WarningSev: "WARNING",
+	support.ErrorSev:   "ERROR",
+}
+
+// MaxChartUploadSize 上传 chart 包的大小上限（字节），可通过 CHART_UPLOAD_MAX_SIZE 配置
+func MaxChartUploadSize() int64 {
+	if n, err := strconv.ParseInt(os.Getenv("CHART_UPLOAD_MAX_SIZE"), 10, 64); err == nil && n > 0 {
+		return n
+	}
+	return defaultMaxChartSize
+}
+
+// LintFinding helm lint 的单条结果
+type LintFinding struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+}
+
+// ChartValidation 上传的 chart 包通过校验后的结果
+type ChartValidation struct {
+	Chart    *chart.Chart
+	Lint     []LintFinding
+	SignedBy string // 提供 .prov 时为签名者，否则为空
+}
+
+// FileName chart 包的规范文件名 <name>-<version>.tgz
+func (v *ChartValidation) FileName() string {
+	return fmt.Sprintf("%s-%s.tgz", v.Chart.Metadata.Name, v.Chart.Metadata.Version)
+}
+
+// ValidateChartArchive 校验上传的 chart 包：大小、tar 条目路径、Chart.yaml 和 helm lint，
+// provPath 不为空时使用 CHART_VERIFY_KEYRING 指定的公钥校验签名。
+// lint 出现 ERROR 时返回 InvalidArgument，同时返回包含 lint 结果的 ChartValidation
+func ValidateChartArchive(archivePath, provPath string) (*ChartValidation, error) {
+	fi, err := os.Stat(archivePath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "stat chart archive failed: %v", err)
+	}
+	if limit := MaxChartUploadSize(); fi.Size() > limit {
+		return nil, status.Errorf(codes.InvalidArgument, "chart archive is %d bytes, exceeds limit of %d bytes", fi.Size(), limit)
+	}
+	if err := checkArchiveEntries(archivePath); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed chart archive: %v", err)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "open chart archive failed: %v", err)
+	}
+	defer f.Close()
+	ch, err := loader.LoadArchive(f)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "load chart archive failed: %v", err)
+	}
+	if err := ch.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Chart.yaml: %v", err)
+	}
+
+	result := &ChartValidation{Chart: ch}
+	if provPath != "" {
+		signedBy, err := verifyProvenance(archivePath, provPath, result.FileName())
+		if err != nil {
+			return nil, err
+		}
+		result.SignedBy = signedBy
+	}
+
+	// action.Lint 根据 .tgz 后缀判断是否需要解压
+	lint := action.NewLint()
+	lintResult := lint.Run([]string{archivePath}, nil)
+	failed := false
+	for _, msg := range lintResult.Messages {
+		result.Lint = append(result.Lint, LintFinding{
+			Severity: lintSeverities[msg.Severity],
+			Path:     msg.Path,
+			Message:  msg.Err.Error(),
+		})
+		if msg.Severity >= support.ErrorSev {
+			failed = true
+		}
+	}
+	if lintResult.TotalChartsLinted == 0 && len(lintResult.Errors) > 0 {
+		return result, status.Errorf(codes.InvalidArgument, "lint chart failed: %v", lintResult.Errors[0])
+	}
+	if failed {
+		return result, status.Errorf(codes.InvalidArgument, "chart %s failed lint", result.FileName())
+	}
+
+	logger.L().Info("Chart archive validated",
+		zap.String("chart", ch.Metadata.Name),
+		zap.String("version", ch.Metadata.Version),
+		zap.Int("lint_findings", len(result.Lint)),
+		zap.String("signed_by", result.SignedBy))
+	return result, nil
+}
+
+// checkArchiveEntries 逐个检查 tar 条目：只允许普通文件和目录，路径必须是相对路径、
+// 不能跳出 chart 目录，并且都位于同一个顶层目录下；解压后的总大小不能超过 helm 的限制
+func checkArchiveEntries(archivePath string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("not a gzip archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	var root string
+	var total int64
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read tar entry: %w", err)
+		}
+		name := strings.ReplaceAll(hdr.Name, `\`, "/")
+		if path.IsAbs(name) || strings.Contains(name, ":") {
+			return fmt.Errorf("entry %q has an absolute path", hdr.Name)
+		}
+		for _, part := range strings.Split(name, "/") {
+			if part == ".." {
+				return fmt.Errorf("entry %q escapes the chart directory", hdr.Name)
+			}
+		}
+		switch hdr.Typeflag {
+		case tar.TypeReg, tar.TypeDir:
+		case tar.TypeXGlobalHeader, tar.TypeXHeader:
+			continue
+		default:
+			return fmt.Errorf("entry %q is not a regular file or directory", hdr.Name)
+		}
+
+		top := strings.SplitN(strings.TrimPrefix(path.Clean(name), "./"), "/", 2)[0]
+		if root == "" {
+			root = top
+		} else if top != root {
+			return fmt.Errorf("entry %q is outside the chart directory %q", hdr.Name, root)
+		}
+
+		total += hdr.Size
+		if total > loader.MaxDecompressedChartSize {
+			return fmt.Errorf("decompressed chart exceeds %d bytes", loader.MaxDecompressedChartSize)
+		}
+	}
+	if root == "" {
+		return errors.New("archive is empty")
+	}
+	return nil
+}
+
+// verifyProvenance 使用 CHART_VERIFY_KEYRING 中的公钥校验 chart 包的 .prov 签名，返回签名者。
+// .prov 中按文件名记录 sha256，上传的临时文件需要以 fileName 命名后再校验
+func verifyProvenance(archivePath, provPath, fileName string) (string, error) {
+	keyring := os.Getenv("CHART_VERIFY_KEYRING")
+	if keyring == "" {
+		return "", status.Errorf(codes.FailedPrecondition, "provenance file provided but CHART_VERIFY_KEYRING is not configured")
+	}
+	sig, err := provenance.NewFromKeyring(keyring, "")
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "load keyring failed: %v", err)
+	}
+	if filepath.Base(archivePath) != fileName {
+		dir, err := os.MkdirTemp("", "helm-verify")
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "create temp directory failed: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		named := filepath.Join(dir, fileName)
+		if err := copyFile(archivePath, named); err != nil {
+			return "", status.Errorf(codes.Internal, "copy chart archive failed: %v", err)
+		}
+		archivePath = named
+	}
+	verification, err := sig.Verify(archivePath, provPath)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "provenance verification failed: %v", err)
+	}
+	var signedBy string
+	if verification.SignedBy != nil {
+		for name := range verification.SignedBy.Identities {
+			signedBy = name
+			break
+		}
+	}
+	return signedBy, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// uploadState 流式上传的元数据，与分片文件一起保存在上传目录中用于续传
+type uploadState struct {
+	FileName   string    `json:"file_name"`
+	Size       int64     `json:"size"`
+	SHA256     string    `json:"sha256"`
+	RepoName   string    `json:"repo_name"`
+	Provenance []byte    `json:"provenance,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// chartUploadDir 流式上传的暂存目录，可通过 CHART_UPLOAD_DIR 配置
+func chartUploadDir() string {
+	if dir := os.Getenv("CHART_UPLOAD_DIR"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), "helm-grpc-uploads")
+}
+
+func (s *HelmManagerServer) UploadChart(stream pb.HelmManagerService_UploadChartServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Errorf(codes.InvalidArgument, "metadata is required")
+	}
+	if err != nil {
+		return err
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must be metadata")
+	}
+	logger.L().Info("UploadChart called",
+		zap.String("upload_id", meta.GetUploadId()),
+		zap.String("file_name", meta.GetFileName()),
+		zap.Int64("size", meta.GetSize()),
+		zap.Int64("offset", meta.GetOffset()))
+
+	dir := chartUploadDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return status.Errorf(codes.Internal, "create upload directory failed: %v", err)
+	}
+	cleanupExpiredUploads(dir)
+
+	id, state, err := openUpload(dir, meta)
+	if err != nil {
+		return err
+	}
+	if _, loaded := activeUploads.LoadOrStore(id, struct{}{}); loaded {
+		return status.Errorf(codes.Aborted, "upload %s is already in progress", id)
+	}
+	defer activeUploads.Delete(id)
+
+	partPath := filepath.Join(dir, id+".part")
+	part, err := os.OpenFile(partPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return status.Errorf(codes.Internal, "open upload file failed: %v", err)
+	}
+	defer part.Close()
+	fi, err := part.Stat()
+	if err != nil {
+		return status.Errorf(codes.Internal, "stat upload file failed: %v", err)
+	}
+	received := fi.Size()
+	// 上传ID和续传位置先通过响应头返回，流在传输中断开时客户端仍能续传
+	if err := stream.SendHeader(metadata.Pairs(
+		uploadIDHeader, id,
+		uploadOffsetHeader, strconv.FormatInt(received, 10),
+	)); err != nil {
+		return err
+	}
+	if meta.GetOffset() != received {
+		return status.Errorf(codes.FailedPrecondition, "upload %s has received %d bytes, resume from offset %d", id, received, received)
+	}
+
+	// 分片逐个追加写入，连接中断时已写入的部分保留用于续传
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			logger.L().Warn("Chart upload interrupted", zap.String("upload_id", id), zap.Int64("received", received), zap.Error(err))
+			return err
+		}
+		if req.GetMetadata() != nil {
+			return status.Errorf(codes.InvalidArgument, "metadata must only be sent in the first message")
+		}
+		chunk := req.GetChunk()
+		if received+int64(len(chunk)) > state.Size {
+			return status.Errorf(codes.InvalidArgument, "upload %s exceeds declared size of %d bytes", id, state.Size)
+		}
+		if _, err := part.Write(chunk); err != nil {
+			return status.Errorf(codes.Internal, "write upload file failed: %v", err)
+		}
+		received += int64(len(chunk))
+	}
+	if err := part.Close(); err != nil {
+		return status.Errorf(codes.Internal, "write upload file failed: %v", err)
+	}
+
+	resp := &pb.UploadChartResponse{UploadId: id, Received: received}
+	if received < state.Size {
+		resp.Code = 0
+		resp.Success = true
+		resp.Message = fmt.Sprintf("Received %d of %d bytes", received, state.Size)
+		return stream.SendAndClose(resp)
+	}
+
+	// 接收完整后无论成功与否都不再续传
+	defer removeUpload(dir, id)
+	digest, err := provenance.DigestFile(partPath)
+	if err != nil {
+		return status.Errorf(codes.Internal, "digest upload file failed: %v", err)
+	}
+	if digest != state.SHA256 {
+		return status.Errorf(codes.InvalidArgument, "sha256 mismatch: expected %s, got %s", state.SHA256, digest)
+	}
+	resp.Digest = "sha256:" + digest
+
+	// action.Lint 需要 .tgz 后缀
+	archivePath := filepath.Join(dir, id+".tgz")
+	if err := os.Rename(partPath, archivePath); err != nil {
+		return status.Errorf(codes.Internal, "rename upload file failed: %v", err)
+	}
+	var provPath string
+	if len(state.Provenance) > 0 {
+		provPath = archivePath + ".prov"
+		if err := os.WriteFile(provPath, state.Provenance, 0600); err != nil {
+			return status.Errorf(codes.Internal, "write provenance file failed: %v", err)
+		}
+	}
+
+	validation, err := ValidateChartArchive(archivePath, provPath)
+	if validation != nil {
+		for _, finding := range validation.Lint {
+			resp.Lint = append(resp.Lint, &pb.ChartLintMessage{
+				Severity: finding.Severity,
+				Path:     finding.Path,
+				Message:  finding.Message,
+			})
+		}
+	}
+	if err != nil {
+		// lint 失败时返回 lint 结果，其他校验错误直接返回
+		if validation == nil {
+			return err
+		}
+		resp.Code = 1
+		resp.Success = false
+		resp.Message = status.Convert(err).Message()
+		return stream.SendAndClose(resp)
+	}
+	chartMeta := validation.Chart.Metadata
+	resp.ChartName = chartMeta.Name
+	resp.ChartVersion = chartMeta.Version
+	resp.AppVersion = chartMeta.AppVersion
+	resp.SignedBy = validation.SignedBy
+
+	chartURL, err := PublishChart(archivePath, provPath, state.RepoName, validation.FileName())
+	if err != nil {
+		logger.L().Error("Failed to publish uploaded chart", zap.String("upload_id", id), zap.Error(err))
+		return err
+	}
+	resp.Code = 0
+	resp.Success = true
+	resp.Completed = true
+	resp.ChartUrl = chartURL
+	resp.Message = "Chart uploaded successfully"
+	logger.L().Info("Chart uploaded successfully via gRPC",
+		zap.String("upload_id", id),
+		zap.String("chart_name", chartMeta.Name),
+		zap.String("chart_version", chartMeta.Version),
+		zap.String("repo_name", state.RepoName))
+	return stream.SendAndClose(resp)
+}
+
+// openUpload 新建上传或加载已有上传的元数据。续传时文件大小和 sha256 必须与首次上传一致
+func openUpload(dir string, meta *pb.UploadChartMetadata) (string, *uploadState, error) {
+	sum := strings.ToLower(strings.TrimPrefix(meta.GetSha256(), "sha256:"))
+	if _, err := hex.DecodeString(sum); err != nil || len(sum) != sha256.Size*2 {
+		return "", nil, status.Errorf(codes.InvalidArgument, "sha256 must be a hex encoded sha256 digest")
+	}
+
+	if id := meta.GetUploadId(); id != "" {
+		// upload_id 用于拼接文件路径，必须是服务端生成的 uuid
+		if _, err := uuid.Parse(id); err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "invalid upload_id %q", id)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, id+".json"))
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil, status.Errorf(codes.NotFound, "upload %s not found or expired", id)
+		}
+		if err != nil {
+			return "", nil, status.Errorf(codes.Internal, "read upload state failed: %v", err)
+		}
+		state := &uploadState{}
+		if err := json.Unmarshal(data, state); err != nil {
+			return "", nil, status.Errorf(codes.Internal, "decode upload state failed: %v", err)
+		}
+		if state.Size != meta.GetSize() || state.SHA256 != sum {
+			return "", nil, status.Errorf(codes.FailedPrecondition, "upload %s was started with a different size or sha256", id)
+		}
+		return id, state, nil
+	}
+
+	if meta.GetSize() <= 0 {
+		return "", nil, status.Errorf(codes.InvalidArgument, "size must be positive")
+	}
+	if limit := MaxChartUploadSize(); meta.GetSize() > limit {
+		return "", nil, status.Errorf(codes.InvalidArgument, "chart archive is %d bytes, exceeds limit of %d bytes", meta.GetSize(), limit)
+	}
+	if len(meta.GetProvenance()) > maxProvenanceSize {
+		return "", nil, status.Errorf(codes.InvalidArgument, "provenance exceeds limit of %d bytes", maxProvenanceSize)
+	}
+	state := &uploadState{
+		FileName:   meta.GetFileName(),
+		Size:       meta.GetSize(),
+		SHA256:     sum,
+		RepoName:   meta.GetRepoName(),
+		Provenance: meta.GetProvenance(),
+		CreatedAt:  time.Now(),
+	}
+	if state.RepoName == "" {
+		state.RepoName = defaultUploadRepo
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "encode upload state failed: %v", err)
+	}
+	id := uuid.NewString()
+	if err := os.WriteFile(filepath.Join(dir, id+".json"), data, 0600); err != nil {
+		return "", nil, status.Errorf(codes.Internal, "write upload state failed: %v", err)
+	}
+	return id, state, nil
+}
+
+func removeUpload(dir, id string) {
+	for _, suffix := range []string{".json", ".part", ".tgz", ".tgz.prov"} {
+		os.Remove(filepath.Join(dir, id+suffix))
+	}
+}
+
+// cleanupExpiredUploads 清理超过 defaultUploadTTL 没有新分片的未完成上传
+func cleanupExpiredUploads(dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		if _, active := activeUploads.Load(id); active {
+			continue
+		}
+		// 以最后一次写入分片的时间为准
+		info, err := os.Stat(filepath.Join(dir, id+".part"))
+		if err != nil {
+			info, err = entry.Info()
+		}
+		if err != nil || time.Since(info.ModTime()) < defaultUploadTTL {
+			continue
+		}
+		logger.L().Info("Removing expired chart upload", zap.String("upload_id", id))
+		removeUpload(dir, id)
+	}
+}
@@ -0,0 +1,161 @@
+package helm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/chartrepo"
+)
+
+// fakeUploadStream 依次返回 msgs，之后返回 err（为 nil 时返回 io.EOF）
+type fakeUploadStream struct {
+	grpc.ServerStream
+	msgs   []*pb.UploadChartRequest
+	err    error
+	header metadata.MD
+	resp   *pb.UploadChartResponse
+}
+
+func (s *fakeUploadStream) Recv() (*pb.UploadChartRequest, error) {
+	if len(s.msgs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *fakeUploadStream) SendHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeUploadStream) SendAndClose(resp *pb.UploadChartResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func (s *fakeUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+// packageTestChart 生成 helm create 的默认 chart 并打包
+func packageTestChart(t *testing.T) []byte {
+	t.Helper()
+	dir := t.TempDir()
+	chartDir, err := chartutil.Create("upload-demo", dir)
+	if err != nil {
+		t.Fatalf("create chart: %v", err)
+	}
+	ch, err := loader.Load(chartDir)
+	if err != nil {
+		t.Fatalf("load chart: %v", err)
+	}
+	archive, err := chartutil.Save(ch, dir)
+	if err != nil {
+		t.Fatalf("package chart: %v", err)
+	}
+	data, err := os.ReadFile(archive)
+	if err != nil {
+		t.Fatalf("read chart archive: %v", err)
+	}
+	return data
+}
+
+func chunkMessages(data []byte) []*pb.UploadChartRequest {
+	return []*pb.UploadChartRequest{{Payload: &pb.UploadChartRequest_Chunk{Chunk: data}}}
+}
+
+func TestUploadChartResumeAfterBrokenStream(t *testing.T) {
+	t.Setenv("CHART_UPLOAD_DIR", t.TempDir())
+	backend, err := chartrepo.NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileBackend: %v", err)
+	}
+	repo, err := chartrepo.New(backend)
+	if err != nil {
+		t.Fatalf("chartrepo.New: %v", err)
+	}
+	oldRepo, oldURL := localRepo, localRepoURL
+	localRepo, localRepoURL = repo, "http://127.0.0.1:8081/chartrepo"
+	defer func() { localRepo, localRepoURL = oldRepo, oldURL }()
+
+	data := packageTestChart(t)
+	sum := sha256.Sum256(data)
+	meta := &pb.UploadChartMetadata{
+		FileName: "upload-demo-0.1.0.tgz",
+		Size:     int64(len(data)),
+		Sha256:   hex.EncodeToString(sum[:]),
+	}
+	half := len(data) / 2
+	s := &HelmManagerServer{}
+
+	// 第一次上传在发送一半后断开，客户端只能从响应头得到 upload_id 和续传位置
+	broken := &fakeUploadStream{
+		msgs: append([]*pb.UploadChartRequest{{Payload: &pb.UploadChartRequest_Metadata{Metadata: meta}}},
+			chunkMessages(data[:half])...),
+		err: status.Error(codes.Unavailable, "connection reset"),
+	}
+	if err := s.UploadChart(broken); status.Code(err) != codes.Unavailable {
+		t.Fatalf("UploadChart() error = %v, want the stream error", err)
+	}
+	if broken.resp != nil {
+		t.Fatalf("broken stream must not receive a response, got %v", broken.resp)
+	}
+	ids := broken.header.Get(uploadIDHeader)
+	if len(ids) != 1 || ids[0] == "" {
+		t.Fatalf("upload-id header = %v", ids)
+	}
+	if offsets := broken.header.Get(uploadOffsetHeader); len(offsets) != 1 || offsets[0] != "0" {
+		t.Fatalf("upload-offset header = %v, want 0", offsets)
+	}
+
+	// 续传时先只发送元数据查询已接收的字节数，再从该位置继续
+	probe := &fakeUploadStream{msgs: []*pb.UploadChartRequest{{Payload: &pb.UploadChartRequest_Metadata{Metadata: &pb.UploadChartMetadata{
+		UploadId: ids[0], Size: meta.Size, Sha256: meta.Sha256,
+	}}}}}
+	if err := s.UploadChart(probe); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("probe UploadChart() error = %v, want FailedPrecondition for offset 0", err)
+	}
+	offsets := probe.header.Get(uploadOffsetHeader)
+	if len(offsets) != 1 || offsets[0] != strconv.Itoa(half) {
+		t.Fatalf("upload-offset header after resume = %v, want %d", offsets, half)
+	}
+
+	resumed := &fakeUploadStream{
+		msgs: append([]*pb.UploadChartRequest{{Payload: &pb.UploadChartRequest_Metadata{Metadata: &pb.UploadChartMetadata{
+			UploadId: ids[0], Size: meta.Size, Sha256: meta.Sha256, Offset: int64(half),
+		}}}}, chunkMessages(data[half:])...),
+	}
+	if err := s.UploadChart(resumed); err != nil {
+		t.Fatalf("resumed UploadChart() error = %v", err)
+	}
+	resp := resumed.resp
+	if resp == nil || !resp.GetCompleted() || resp.GetUploadId() != ids[0] || resp.GetReceived() != int64(len(data)) {
+		t.Fatalf("response = %v", resp)
+	}
+	if resp.GetChartName() != "upload-demo" || resp.GetChartUrl() != localRepoURL+"/upload-demo-0.1.0.tgz" {
+		t.Errorf("chart = %s, url = %s", resp.GetChartName(), resp.GetChartUrl())
+	}
+	if _, err := os.Stat(filepath.Join(chartUploadDir(), ids[0]+".part")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("upload file must be removed after completion, stat error = %v", err)
+	}
+}
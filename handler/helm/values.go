@@ -0,0 +1,82 @@
+package helm
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/strvals"
+	"sigs.k8s.io/yaml"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+)
+
+// unmarshalValues 解析 YAML 或 JSON 格式的 values 文档，嵌套的对象解析为 map[string]interface{}
+func unmarshalValues(data string, out *map[string]interface{}) error {
+	if err := yaml.Unmarshal([]byte(data), out); err != nil {
+		return err
+	}
+	if *out == nil {
+		*out = map[string]interface{}{}
+	}
+	return nil
+}
+
+// chartSpecValues 按 helm 命令行的优先级合并 ChartSpec 中的 values：
+// values_yaml < values（按 key 排序后逐个作为 --set）< set < set_string
+func chartSpecValues(spec *pb.ChartSpec) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(spec.GetValuesYaml()) != "" {
+		if err := unmarshalValues(spec.GetValuesYaml(), &values); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid values_yaml: %v", err)
+		}
+	}
+
+	keys := make([]string, 0, len(spec.GetValues()))
+	for k := range spec.GetValues() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		// 值中的逗号和反斜杠需要转义，否则会被当成多个 --set 参数
+		set := k + "=" + escapeSetValue(spec.GetValues()[k])
+		if err := strvals.ParseInto(set, values); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid values key %q: %v", k, err)
+		}
+	}
+
+	for _, set := range spec.GetSet() {
+		if err := strvals.ParseInto(set, values); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid set %q: %v", set, err)
+		}
+	}
+	for _, set := range spec.GetSetString() {
+		if err := strvals.ParseIntoString(set, values); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid set_string %q: %v", set, err)
+		}
+	}
+	return values, nil
+}
+
+func escapeSetValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `,`, `\,`).Replace(v)
+}
+
+// applyValuesStrategy 设置升级时如何处理上次 release 的 values，三个选项互斥
+func applyValuesStrategy(upgrade *action.Upgrade, reuse, reset, resetThenReuse bool) error {
+	n := 0
+	for _, b := range []bool{reuse, reset, resetThenReuse} {
+		if b {
+			n++
+		}
+	}
+	if n > 1 {
+		return status.Errorf(codes.InvalidArgument, "reuse_values, reset_values and reset_then_reuse_values are mutually exclusive")
+	}
+	upgrade.ReuseValues = reuse
+	upgrade.ResetValues = reset
+	upgrade.ResetThenReuseValues = resetThenReuse
+	return nil
+}
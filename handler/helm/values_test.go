@@ -0,0 +1,152 @@
+package helm
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+)
+
+func TestChartSpecValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *pb.ChartSpec
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			spec: &pb.ChartSpec{},
+			want: map[string]interface{}{},
+		},
+		{
+			name: "yaml document keeps nesting and types",
+			spec: &pb.ChartSpec{ValuesYaml: "image:\n  tag: \"1.2\"\nreplicaCount: 3\ningress:\n  enabled: true\n"},
+			want: map[string]interface{}{
+				"image":        map[string]interface{}{"tag": "1.2"},
+				"replicaCount": float64(3),
+				"ingress":      map[string]interface{}{"enabled": true},
+			},
+		},
+		{
+			name: "json document",
+			spec: &pb.ChartSpec{ValuesYaml: `{"image":{"repository":"nginx"},"ports":[80,443]}`},
+			want: map[string]interface{}{
+				"image": map[string]interface{}{"repository": "nginx"},
+				"ports": []interface{}{float64(80), float64(443)},
+			},
+		},
+		{
+			name: "values map keys are set paths with type coercion",
+			spec: &pb.ChartSpec{Values: map[string]string{
+				"image.tag":       "v2",
+				"replicaCount":    "2",
+				"ingress.enabled": "false",
+			}},
+			want: map[string]interface{}{
+				"image":        map[string]interface{}{"tag": "v2"},
+				"replicaCount": int64(2),
+				"ingress":      map[string]interface{}{"enabled": false},
+			},
+		},
+		{
+			name: "values map value with comma is not split",
+			spec: &pb.ChartSpec{Values: map[string]string{"args": `a,b\c`}},
+			want: map[string]interface{}{"args": `a,b\c`},
+		},
+		{
+			name: "set overrides document and values map",
+			spec: &pb.ChartSpec{
+				ValuesYaml: "image:\n  tag: v1\n  repository: nginx\n",
+				Values:     map[string]string{"image.tag": "v2"},
+				Set:        []string{"image.tag=v3,resources.limits.cpu=500m"},
+			},
+			want: map[string]interface{}{
+				"image":     map[string]interface{}{"tag": "v3", "repository": "nginx"},
+				"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "500m"}},
+			},
+		},
+		{
+			name: "set list index",
+			spec: &pb.ChartSpec{Set: []string{"hosts[0]=a.example.com,hosts[1]=b.example.com"}},
+			want: map[string]interface{}{"hosts": []interface{}{"a.example.com", "b.example.com"}},
+		},
+		{
+			name: "set_string keeps strings",
+			spec: &pb.ChartSpec{
+				Set:       []string{"replicaCount=3"},
+				SetString: []string{"replicaCount=3,image.tag=010"},
+			},
+			want: map[string]interface{}{
+				"replicaCount": "3",
+				"image":        map[string]interface{}{"tag": "010"},
+			},
+		},
+		{
+			name:    "invalid yaml document",
+			spec:    &pb.ChartSpec{ValuesYaml: "image: [unclosed"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid set",
+			spec:    &pb.ChartSpec{Set: []string{"hosts[x]=a"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := chartSpecValues(tt.spec)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("expected InvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyValuesStrategy(t *testing.T) {
+	tests := []struct {
+		name                         string
+		reuse, reset, resetThenReuse bool
+		wantErr                      bool
+	}{
+		{name: "default"},
+		{name: "reuse", reuse: true},
+		{name: "reset", reset: true},
+		{name: "reset then reuse", resetThenReuse: true},
+		{name: "reuse and reset", reuse: true, reset: true, wantErr: true},
+		{name: "all", reuse: true, reset: true, resetThenReuse: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrade := &action.Upgrade{}
+			err := applyValuesStrategy(upgrade, tt.reuse, tt.reset, tt.resetThenReuse)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("expected InvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if upgrade.ReuseValues != tt.reuse || upgrade.ResetValues != tt.reset || upgrade.ResetThenReuseValues != tt.resetThenReuse {
+				t.Errorf("unexpected strategy: %+v", upgrade)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package helm
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// chartVersion 待过滤排序的版本，details 只有普通仓库才有
+type chartVersion struct {
+	semver  *semver.Version
+	details *repo.ChartVersion
+}
+
+func (s *HelmManagerServer) ListChartVersions(ctx context.Context, req *pb.ListChartVersionsRequest) (*pb.ListChartVersionsResponse, error) {
+	logger.L().Info("ListChartVersions called", zap.String("request", req.String()))
+	if req.GetChartName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chart_name is required")
+	}
+
+	var constraint *semver.Constraints
+	if req.GetConstraint() != "" {
+		c, err := semver.NewConstraint(req.GetConstraint())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid version constraint %q: %v", req.GetConstraint(), err)
+		}
+		constraint = c
+	}
+
+	entry, err := getRepoEntry(req.GetRepoName())
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []chartVersion
+	if registry.IsOCI(entry.URL) {
+		// OCI 仓库只有 tag，没有 index.yaml 中的详细信息
+		tags, err := ociChartTags(entry, req.GetChartName())
+		if err != nil {
+			logger.L().Error("Failed to list chart tags", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "list chart tags failed: %v", err)
+		}
+		for _, tag := range tags {
+			if v, err := semver.NewVersion(tag); err == nil {
+				versions = append(versions, chartVersion{semver: v})
+			}
+		}
+	} else {
+		indexFile, err := repoIndexes.Get(entry)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load repository index failed: %v", err)
+		}
+		chartVersions, ok := indexFile.Entries[req.GetChartName()]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "chart %q not found in repository %q", req.GetChartName(), entry.Name)
+		}
+		for _, cv := range chartVersions {
+			v, err := semver.NewVersion(cv.Version)
+			if err != nil {
+				logger.L().Warn("Skipping chart version that is not valid semver",
+					zap.String("chart", cv.Name), zap.String("version", cv.Version))
+				continue
+			}
+			versions = append(versions, chartVersion{semver: v, details: cv})
+		}
+	}
+
+	versions = filterChartVersions(versions, constraint, req.GetIncludePrerelease())
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].semver.GreaterThan(versions[j].semver)
+	})
+
+	resp := &pb.ListChartVersionsResponse{
+		Versions: make([]*pb.ChartVersionInfo, 0, len(versions)),
+		RepoUrl:  entry.URL,
+	}
+	for _, v := range versions {
+		info := &pb.ChartVersionInfo{Version: v.semver.Original()}
+		if req.GetWithDetails() && v.details != nil {
+			info.AppVersion = v.details.AppVersion
+			info.Description = v.details.Description
+			info.Digest = v.details.Digest
+			info.Deprecated = v.details.Deprecated
+			if !v.details.Created.IsZero() {
+				info.Created = v.details.Created.Format(time.RFC3339)
+			}
+		}
+		resp.Versions = append(resp.Versions, info)
+	}
+	logger.L().Info("Chart versions listed", zap.String("chart", req.GetChartName()), zap.Int("count", len(resp.Versions)))
+	return resp, nil
+}
+
+// filterChartVersions 按 semver 约束过滤版本。不包含预发布版本时直接去掉；
+// 包含时预发布版本按去掉预发布标识后的版本匹配约束，
+// 否则 ">=1.2 <2" 这类约束永远不会命中 1.3.0-rc1
+func filterChartVersions(versions []chartVersion, constraint *semver.Constraints, includePrerelease bool) []chartVersion {
+	filtered := make([]chartVersion, 0, len(versions))
+	for _, v := range versions {
+		if v.semver.Prerelease() != "" && !includePrerelease {
+			continue
+		}
+		if constraint != nil {
+			check := v.semver
+			if v.semver.Prerelease() != "" {
+				release, err := v.semver.SetPrerelease("")
+				if err != nil {
+					continue
+				}
+				check = &release
+			}
+			if !constraint.Check(check) {
+				continue
+			}
+		}
+		filtered = append(filtered, v)
+	}
+	return filtered
+}
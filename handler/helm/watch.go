@@ -0,0 +1,409 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"sigs.k8s.io/yaml"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/logger"
+)
+
+// deploymentRevisionAnnotation Deployment 控制器记录在 Deployment 和 ReplicaSet 上的版本号，
+// 两者相同的 ReplicaSet 即为当前版本
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// WatchInstallStatus 的阶段
+const (
+	PhasePending    = "Pending"
+	PhaseInstalling = "Installing"
+	PhaseDeployed   = "Deployed"
+	PhaseFailed     = "Failed"
+)
+
+// WatchInstallStatus 监控 release 安装进度，到达 Deployed 或 Failed 后关闭流
+func (s *HelmManagerServer) WatchInstallStatus(req *pb.WatchInstallStatusRequest, stream pb.HelmManagerService_WatchInstallStatusServer) error {
+	logger.L().Info("WatchInstallStatus called", zap.String("request", req.String()))
+	namespace := req.GetNamespace()
+	releaseName := req.GetReleaseName()
+	if namespace == "" || releaseName == "" {
+		return status.Errorf(codes.InvalidArgument, "namespace and release_name are required")
+	}
+
+	actionConfig, err := newActionConfig(namespace)
+	if err != nil {
+		logger.L().Error("Failed to initialize Helm action configuration for watch", zap.Error(err))
+		return status.Errorf(codes.Internal, "initialize helm action failed: %v", err)
+	}
+	clientset, err := kubeClientset()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create k8s clientset: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	w := &releaseWatcher{
+		namespace:    namespace,
+		releaseName:  releaseName,
+		actionConfig: actionConfig,
+		deployments:  map[string]*appsv1.Deployment{},
+		statefulSets: map[string]*appsv1.StatefulSet{},
+		replicaSets:  map[string]*appsv1.ReplicaSet{},
+		pods:         map[string]*v1.Pod{},
+	}
+	events, err := w.start(ctx, clientset)
+	if err != nil {
+		logger.L().Error("Failed to start release watch", zap.Error(err))
+		return status.Errorf(codes.Internal, "watch release %s/%s failed: %v", namespace, releaseName, err)
+	}
+
+	var last *pb.InstallStatus
+	for {
+		current := w.evaluate()
+		if last == nil || current.Phase != last.Phase || current.Message != last.Message {
+			if err := stream.Send(current); err != nil {
+				return err
+			}
+			last = current
+		}
+		if current.Phase == PhaseDeployed || current.Phase == PhaseFailed {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "watch of release %s/%s closed", namespace, releaseName)
+			}
+			w.apply(ev)
+		}
+	}
+}
+
+// releaseWatcher 维护 release 及其工作负载的最新状态，状态全部由 watch 事件驱动。
+// 需要等待的工作负载取自 release manifest，与 GetReleaseResources 一致，不依赖 chart 是否设置了实例标签；
+// namespace 下的工作负载、ReplicaSet 和 Pod 都会被 watch，只有 manifest 中的部分参与计算
+type releaseWatcher struct {
+	namespace    string
+	releaseName  string
+	actionConfig *action.Configuration
+
+	release          *release.Release
+	releaseErr       error
+	wantDeployments  map[string]bool
+	wantStatefulSets map[string]bool
+	deployments      map[string]*appsv1.Deployment
+	statefulSets     map[string]*appsv1.StatefulSet
+	replicaSets      map[string]*appsv1.ReplicaSet
+	pods             map[string]*v1.Pod
+}
+
+// start 先 List 出初始状态，再从对应的 resourceVersion 开始 watch，所有事件合并到一个 channel
+func (w *releaseWatcher) start(ctx context.Context, clientset kubernetes.Interface) (<-chan watch.Event, error) {
+	w.reloadRelease()
+
+	// helm 使用 secret 存储 release，标签为 owner=helm,name=<release>
+	storageSelector := fmt.Sprintf("owner=helm,name=%s", w.releaseName)
+
+	secrets := clientset.CoreV1().Secrets(w.namespace)
+	deployments := clientset.AppsV1().Deployments(w.namespace)
+	statefulSets := clientset.AppsV1().StatefulSets(w.namespace)
+	replicaSets := clientset.AppsV1().ReplicaSets(w.namespace)
+	pods := clientset.CoreV1().Pods(w.namespace)
+
+	secretList, err := secrets.List(ctx, metav1.ListOptions{LabelSelector: storageSelector})
+	if err != nil {
+		return nil, err
+	}
+	depList, err := deployments.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range depList.Items {
+		w.deployments[depList.Items[i].Name] = &depList.Items[i]
+	}
+	stsList, err := statefulSets.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range stsList.Items {
+		w.statefulSets[stsList.Items[i].Name] = &stsList.Items[i]
+	}
+	rsList, err := replicaSets.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rsList.Items {
+		w.replicaSets[rsList.Items[i].Name] = &rsList.Items[i]
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		w.pods[podList.Items[i].Name] = &podList.Items[i]
+	}
+
+	sources := []struct {
+		resourceVersion string
+		selector        string
+		watch           func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	}{
+		{secretList.ResourceVersion, storageSelector, secrets.Watch},
+		{depList.ResourceVersion, "", deployments.Watch},
+		{stsList.ResourceVersion, "", statefulSets.Watch},
+		{rsList.ResourceVersion, "", replicaSets.Watch},
+		{podList.ResourceVersion, "", pods.Watch},
+	}
+
+	events := make(chan watch.Event)
+	var closeOnce sync.Once
+	for _, src := range sources {
+		selector, watchFunc := src.selector, src.watch
+		rw, err := watchtools.NewRetryWatcherWithContext(ctx, src.resourceVersion, &cache.ListWatch{
+			WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+				opts.LabelSelector = selector
+				return watchFunc(ctx, opts)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			defer rw.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case ev, ok := <-rw.ResultChan():
+					if !ok {
+						// 任意一个 watch 无法恢复时结束整个监控
+						closeOnce.Do(func() { close(events) })
+						return
+					}
+					select {
+					case events <- ev:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+	return events, nil
+}
+
+// apply 根据 watch 事件更新本地状态
+func (w *releaseWatcher) apply(ev watch.Event) {
+	if ev.Type == watch.Error {
+		logger.L().Warn("Release watch error event", zap.Any("object", ev.Object))
+		return
+	}
+	switch obj := ev.Object.(type) {
+	case *v1.Secret:
+		w.reloadRelease()
+	case *appsv1.Deployment:
+		applyEvent(w.deployments, ev.Type, obj.Name, obj)
+	case *appsv1.StatefulSet:
+		applyEvent(w.statefulSets, ev.Type, obj.Name, obj)
+	case *appsv1.ReplicaSet:
+		applyEvent(w.replicaSets, ev.Type, obj.Name, obj)
+	case *v1.Pod:
+		applyEvent(w.pods, ev.Type, obj.Name, obj)
+	}
+}
+
+func applyEvent[T runtime.Object](objects map[string]T, eventType watch.EventType, name string, obj T) {
+	if eventType == watch.Deleted {
+		delete(objects, name)
+		return
+	}
+	objects[name] = obj
+}
+
+func (w *releaseWatcher) reloadRelease() {
+	rel, err := w.actionConfig.Releases.Last(w.releaseName)
+	if err != nil {
+		w.release = nil
+		w.releaseErr = nil
+		if err != driver.ErrReleaseNotFound {
+			w.releaseErr = err
+		}
+		w.wantDeployments, w.wantStatefulSets = nil, nil
+		return
+	}
+	w.release = rel
+	w.releaseErr = nil
+	w.wantDeployments, w.wantStatefulSets = manifestWorkloads(rel.Manifest, w.namespace)
+}
+
+// manifestWorkloads 返回 manifest 中位于 namespace 下的 Deployment 和 StatefulSet 名称
+func manifestWorkloads(manifest, namespace string) (deployments, statefulSets map[string]bool) {
+	deployments, statefulSets = map[string]bool{}, map[string]bool{}
+	for _, doc := range splitManifests(manifest) {
+		var content map[string]any
+		if err := yaml.Unmarshal([]byte(doc), &content); err != nil || len(content) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: content}
+		if ns := obj.GetNamespace(); ns != "" && ns != namespace {
+			continue
+		}
+		switch obj.GroupVersionKind().GroupKind() {
+		case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
+			deployments[obj.GetName()] = true
+		case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+			statefulSets[obj.GetName()] = true
+		}
+	}
+	return deployments, statefulSets
+}
+
+// evaluate 结合 release 状态和工作负载就绪情况计算当前阶段
+func (w *releaseWatcher) evaluate() *pb.InstallStatus {
+	if w.release == nil {
+		msg := "waiting for release to be created"
+		if w.releaseErr != nil {
+			msg = fmt.Sprintf("failed to read release: %v", w.releaseErr)
+		}
+		return &pb.InstallStatus{Phase: PhasePending, Message: msg}
+	}
+
+	switch w.release.Info.Status {
+	case release.StatusFailed:
+		return &pb.InstallStatus{Phase: PhaseFailed, Message: w.release.Info.Description}
+	case release.StatusUninstalling, release.StatusUninstalled:
+		return &pb.InstallStatus{Phase: PhaseFailed, Message: "release has been uninstalled"}
+	case release.StatusPendingInstall, release.StatusPendingUpgrade, release.StatusPendingRollback:
+		return &pb.InstallStatus{Phase: PhaseInstalling, Message: w.release.Info.Description}
+	case release.StatusDeployed:
+	default:
+		return &pb.InstallStatus{Phase: PhasePending, Message: fmt.Sprintf("release status %s", w.release.Info.Status)}
+	}
+
+	pods := w.currentPods()
+	for _, pod := range pods {
+		// 被驱逐的 Pod 会由控制器重建，不代表安装失败
+		if pod.Status.Phase == v1.PodFailed && pod.Status.Reason != "Evicted" {
+			return &pb.InstallStatus{Phase: PhaseFailed, Message: fmt.Sprintf("pod %s failed: %s", pod.Name, pod.Status.Message)}
+		}
+	}
+
+	var waiting []string
+	for name := range w.wantDeployments {
+		dep, ok := w.deployments[name]
+		if !ok {
+			waiting = append(waiting, fmt.Sprintf("deployment/%s (not found)", name))
+		} else if !deploymentReady(dep) {
+			waiting = append(waiting, fmt.Sprintf("deployment/%s (%d/%d ready)", dep.Name, dep.Status.ReadyReplicas, replicas(dep.Spec.Replicas)))
+		}
+	}
+	for name := range w.wantStatefulSets {
+		sts, ok := w.statefulSets[name]
+		if !ok {
+			waiting = append(waiting, fmt.Sprintf("statefulset/%s (not found)", name))
+		} else if !statefulSetReady(sts) {
+			waiting = append(waiting, fmt.Sprintf("statefulset/%s (%d/%d ready)", sts.Name, sts.Status.ReadyReplicas, replicas(sts.Spec.Replicas)))
+		}
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if podStatus := calculatePodStatus(pod); podStatus != string(v1.PodRunning) && podStatus != string(v1.PodSucceeded) {
+			waiting = append(waiting, fmt.Sprintf("pod/%s (%s)", pod.Name, podStatus))
+		}
+	}
+	if len(waiting) > 0 {
+		sort.Strings(waiting)
+		return &pb.InstallStatus{Phase: PhaseInstalling, Message: "waiting for " + strings.Join(waiting, ", ")}
+	}
+
+	return &pb.InstallStatus{
+		Phase:   PhaseDeployed,
+		Message: fmt.Sprintf("%d deployments and %d statefulsets ready", len(w.wantDeployments), len(w.wantStatefulSets)),
+	}
+}
+
+// currentPods 返回 manifest 中工作负载当前版本的 Pod：Deployment 当前 ReplicaSet 的 Pod，以及
+// StatefulSet 中 controller-revision-hash 等于 updateRevision 的 Pod。旧版本中失败或正在被替换的 Pod 不计入
+func (w *releaseWatcher) currentPods() []*v1.Pod {
+	// 控制器 UID -> 要求的 controller-revision-hash，为空时不检查
+	current := map[types.UID]string{}
+	for name := range w.wantDeployments {
+		dep, ok := w.deployments[name]
+		if !ok {
+			continue
+		}
+		for _, rs := range w.replicaSets {
+			ref := metav1.GetControllerOf(rs)
+			if ref != nil && ref.UID == dep.UID &&
+				rs.Annotations[deploymentRevisionAnnotation] == dep.Annotations[deploymentRevisionAnnotation] {
+				current[rs.UID] = ""
+			}
+		}
+	}
+	for name := range w.wantStatefulSets {
+		if sts, ok := w.statefulSets[name]; ok {
+			current[sts.UID] = sts.Status.UpdateRevision
+		}
+	}
+
+	var pods []*v1.Pod
+	for _, pod := range w.pods {
+		ref := metav1.GetControllerOf(pod)
+		if ref == nil {
+			continue
+		}
+		revision, ok := current[ref.UID]
+		if !ok || (revision != "" && pod.Labels[appsv1.ControllerRevisionHashLabelKey] != revision) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods
+}
+
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+	return *r
+}
+
+func deploymentReady(dep *appsv1.Deployment) bool {
+	want := replicas(dep.Spec.Replicas)
+	return dep.Status.ObservedGeneration >= dep.Generation &&
+		dep.Status.UpdatedReplicas == want &&
+		dep.Status.AvailableReplicas == want
+}
+
+func statefulSetReady(sts *appsv1.StatefulSet) bool {
+	want := replicas(sts.Spec.Replicas)
+	return sts.Status.ObservedGeneration >= sts.Generation &&
+		sts.Status.ReadyReplicas == want
+}
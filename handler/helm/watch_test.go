@@ -0,0 +1,165 @@
+package helm
+
+import (
+	"testing"
+
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const watchTestManifest = `---
+# Source: demo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+---
+# Source: demo/templates/statefulset.yaml
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+---
+# Source: demo/templates/other.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: elsewhere
+  namespace: other
+`
+
+func controlledBy(uid types.UID) []metav1.OwnerReference {
+	controller := true
+	return []metav1.OwnerReference{{UID: uid, Controller: &controller}}
+}
+
+func watchTestPod(name string, owner types.UID, revision string, phase v1.PodPhase) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: controlledBy(owner)},
+		Status:     v1.PodStatus{Phase: phase},
+	}
+	if revision != "" {
+		pod.Labels = map[string]string{appsv1.ControllerRevisionHashLabelKey: revision}
+	}
+	return pod
+}
+
+// newTestReleaseWatcher 构造 web 已滚动到第 2 版、db 已更新到 db-2 且全部就绪的状态，
+// 工作负载都没有 app.kubernetes.io/instance 标签
+func newTestReleaseWatcher() *releaseWatcher {
+	one := int32(1)
+	w := &releaseWatcher{
+		namespace: "default",
+		release: &release.Release{
+			Name:     "demo",
+			Info:     &release.Info{Status: release.StatusDeployed},
+			Manifest: watchTestManifest,
+		},
+		deployments: map[string]*appsv1.Deployment{"web": {
+			ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "web", Annotations: map[string]string{deploymentRevisionAnnotation: "2"}},
+			Spec:       appsv1.DeploymentSpec{Replicas: &one},
+			Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1, ReadyReplicas: 1},
+		}},
+		statefulSets: map[string]*appsv1.StatefulSet{"db": {
+			ObjectMeta: metav1.ObjectMeta{Name: "db", UID: "db"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &one},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1, UpdateRevision: "db-2"},
+		}},
+		replicaSets: map[string]*appsv1.ReplicaSet{
+			"web-1": {ObjectMeta: metav1.ObjectMeta{Name: "web-1", UID: "web-1", OwnerReferences: controlledBy("web"),
+				Annotations: map[string]string{deploymentRevisionAnnotation: "1"}}},
+			"web-2": {ObjectMeta: metav1.ObjectMeta{Name: "web-2", UID: "web-2", OwnerReferences: controlledBy("web"),
+				Annotations: map[string]string{deploymentRevisionAnnotation: "2"}}},
+		},
+		pods: map[string]*v1.Pod{
+			"web-2-a": watchTestPod("web-2-a", "web-2", "", v1.PodRunning),
+			"db-0":    watchTestPod("db-0", "db", "db-2", v1.PodRunning),
+		},
+	}
+	w.wantDeployments, w.wantStatefulSets = manifestWorkloads(w.release.Manifest, w.namespace)
+	return w
+}
+
+func TestReleaseWatcherEvaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(w *releaseWatcher)
+		wantPhase string
+	}{
+		{
+			name:      "workloads from manifest ready",
+			mutate:    func(*releaseWatcher) {},
+			wantPhase: PhaseDeployed,
+		},
+		{
+			name: "failed pod of the previous replicaset",
+			mutate: func(w *releaseWatcher) {
+				w.pods["web-1-a"] = watchTestPod("web-1-a", "web-1", "", v1.PodFailed)
+			},
+			wantPhase: PhaseDeployed,
+		},
+		{
+			name: "failed pod of the previous statefulset revision",
+			mutate: func(w *releaseWatcher) {
+				w.pods["db-1"] = watchTestPod("db-1", "db", "db-1", v1.PodFailed)
+			},
+			wantPhase: PhaseDeployed,
+		},
+		{
+			name: "evicted pod of the current replicaset",
+			mutate: func(w *releaseWatcher) {
+				pod := watchTestPod("web-2-b", "web-2", "", v1.PodFailed)
+				pod.Status.Reason = "Evicted"
+				w.pods[pod.Name] = pod
+			},
+			wantPhase: PhaseDeployed,
+		},
+		{
+			name: "failed pod of an unrelated workload",
+			mutate: func(w *releaseWatcher) {
+				w.pods["other-a"] = watchTestPod("other-a", "other", "", v1.PodFailed)
+			},
+			wantPhase: PhaseDeployed,
+		},
+		{
+			name: "failed pod of the current replicaset",
+			mutate: func(w *releaseWatcher) {
+				w.pods["web-2-b"] = watchTestPod("web-2-b", "web-2", "", v1.PodFailed)
+			},
+			wantPhase: PhaseFailed,
+		},
+		{
+			name: "failed pod of the current statefulset revision",
+			mutate: func(w *releaseWatcher) {
+				w.pods["db-0"].Status.Phase = v1.PodFailed
+			},
+			wantPhase: PhaseFailed,
+		},
+		{
+			name: "deployment from manifest not created yet",
+			mutate: func(w *releaseWatcher) {
+				delete(w.deployments, "web")
+			},
+			wantPhase: PhaseInstalling,
+		},
+		{
+			name: "current pod not running",
+			mutate: func(w *releaseWatcher) {
+				w.pods["web-2-a"].Status.Phase = v1.PodPending
+			},
+			wantPhase: PhaseInstalling,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestReleaseWatcher()
+			tt.mutate(w)
+			if got := w.evaluate(); got.Phase != tt.wantPhase {
+				t.Errorf("evaluate() = %s (%s), want %s", got.Phase, got.Message, tt.wantPhase)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package helm
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/db"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/tenancy"
+)
+
+// scopeFields EnsureWorkspaceNamespaceRequest 和 WorkspaceScope 共有的字段
+type scopeFields interface {
+	GetWorkspaceId() uint64
+	GetProjectId() uint64
+	GetEnvId() uint64
+	GetAppId() uint64
+}
+
+// workspaceScope 解析请求中的工作空间、项目和环境，指定 app_id 时从 jos_app 读取
+func workspaceScope(req scopeFields) (tenancy.Scope, error) {
+	scope := tenancy.Scope{
+		WorkspaceID: req.GetWorkspaceId(),
+		ProjectID:   req.GetProjectId(),
+		EnvID:       req.GetEnvId(),
+	}
+	if req.GetAppId() != 0 {
+		if db.DB.JosDb == nil {
+			return scope, status.Error(codes.FailedPrecondition, "user center database is not initialized")
+		}
+		app, err := db.DB.GetJosAppByAppID(req.GetAppId())
+		if errors.Is(err, db.ErrAppNotFound) {
+			return scope, status.Errorf(codes.NotFound, "%v", err)
+		}
+		if err != nil {
+			return scope, status.Errorf(codes.Internal, "%v", err)
+		}
+		if scope.WorkspaceID != 0 && scope.WorkspaceID != app.WorkspaceID {
+			return scope, status.Errorf(codes.InvalidArgument, "app %d belongs to workspace %d", app.AppID, app.WorkspaceID)
+		}
+		scope = tenancy.Scope{WorkspaceID: app.WorkspaceID, ProjectID: app.ProjectID, EnvID: app.EnvID}
+	}
+	if scope.WorkspaceID == 0 || scope.ProjectID == 0 {
+		return scope, status.Error(codes.InvalidArgument, "workspace_id and project_id are required")
+	}
+	return scope, nil
+}
+
+// RequestScope 返回安装类请求中用于代替 namespace 的工作空间，未指定时为 nil
+func RequestScope(req interface{}) *pb.WorkspaceScope {
+	if r, ok := req.(interface{ GetScope() *pb.WorkspaceScope }); ok {
+		return r.GetScope()
+	}
+	return nil
+}
+
+// WorkspaceNamespace 返回请求对应的托管命名空间名称，供授权使用；未启用隔离或无法解析时为空
+func WorkspaceNamespace(req scopeFields) string {
+	manager := tenancy.Default()
+	if manager == nil {
+		return ""
+	}
+	scope, err := workspaceScope(req)
+	if err != nil {
+		return ""
+	}
+	cfg := manager.Config()
+	return cfg.Namespace(scope)
+}
+
+// EnsureWorkspaceNamespace 返回工作空间/项目/环境对应的托管命名空间，不存在时创建并配置
+// ResourceQuota、LimitRange 和默认 NetworkPolicy。调用方需属于该工作空间
+func (s *HelmManagerServer) EnsureWorkspaceNamespace(ctx context.Context, req *pb.EnsureWorkspaceNamespaceRequest) (*pb.EnsureWorkspaceNamespaceResponse, error) {
+	logger.L().Info("EnsureWorkspaceNamespace called", zap.String("request", req.String()))
+	manager := tenancy.Default()
+	if manager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "workspace namespace isolation is disabled")
+	}
+	scope, err := workspaceScope(req)
+	if err != nil {
+		return nil, err
+	}
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if err := manager.CheckWorkspace(identity, scope.WorkspaceID); err != nil {
+		return nil, tenancyError(err)
+	}
+
+	namespace, created, err := manager.EnsureNamespace(ctx, scope)
+	if err != nil {
+		return nil, tenancyError(err)
+	}
+	return &pb.EnsureWorkspaceNamespaceResponse{
+		Code:      0,
+		Message:   "Namespace is ready",
+		Success:   true,
+		Namespace: namespace,
+		Created:   created,
+	}, nil
+}
+
+// scopedNamespace 安装类请求指定了工作空间时返回对应的托管命名空间，调用方需属于该工作空间，
+// create 为 true 时确保命名空间已创建；未指定工作空间时返回请求中的 namespace
+func scopedNamespace(ctx context.Context, namespace string, scope *pb.WorkspaceScope, create bool) (string, error) {
+	if scope == nil {
+		return namespace, nil
+	}
+	manager := tenancy.Default()
+	if manager == nil {
+		return "", status.Error(codes.FailedPrecondition, "workspace namespace isolation is disabled")
+	}
+	ts, err := workspaceScope(scope)
+	if err != nil {
+		return "", err
+	}
+	cfg := manager.Config()
+	resolved := cfg.Namespace(ts)
+	if namespace != "" && namespace != resolved {
+		return "", status.Errorf(codes.InvalidArgument, "namespace %s does not match workspace namespace %s", namespace, resolved)
+	}
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if err := manager.CheckWorkspace(identity, ts.WorkspaceID); err != nil {
+		return "", tenancyError(err)
+	}
+	if !create {
+		return resolved, nil
+	}
+	if _, _, err := manager.EnsureNamespace(ctx, ts); err != nil {
+		return "", tenancyError(err)
+	}
+	return resolved, nil
+}
+
+func tenancyError(err error) error {
+	switch {
+	case errors.Is(err, tenancy.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, tenancy.ErrNamespaceConflict):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
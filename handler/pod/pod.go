@@ -1,9 +1,12 @@
 package pod
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/url"
@@ -14,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -21,6 +25,7 @@ import (
 
 	pb "jos-deployment/api/v1alpha1/pb_pod"
 	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/terminal"
 
 	"jos-deployment/handler/helm"
 
@@ -34,6 +39,10 @@ const (
 
 type PodManagerServer struct {
 	pb.UnimplementedPodManagerServiceServer
+	// Terminal 为空时按需使用集群配置创建
+	Terminal *terminal.Terminal
+	// TerminalIdleTimeout 终端空闲超时，为 0 时使用默认值
+	TerminalIdleTimeout time.Duration
 }
 
 type PodMetrics struct {
@@ -58,17 +67,9 @@ func (s *PodManagerServer) DeletePod(ctx context.Context, req *pb.DeletePodReque
 	logger.L().Info("DeletePod called", zap.String("request", req.String()))
 
 	// 删除 Pod 的逻辑
-	config, err := rest.InClusterConfig()
+	clientset, err := newClientset()
 	if err != nil {
-		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to build kubeconfig: %v", err)
-		}
-	}
-	clientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to create Kubernetes client: %v", err)
+		return nil, err
 	}
 	err = clientset.CoreV1().Pods(req.Namespace).Delete(ctx, req.PodName, metav1.DeleteOptions{})
 	if err != nil {
@@ -87,27 +88,100 @@ func (s *PodManagerServer) DeletePod(ctx context.Context, req *pb.DeletePodReque
 
 // GetPodLogs 实现获取 Pod 日志的流式 RPC 方法
 func (s *PodManagerServer) GetPodLogs(req *pb.GetPodLogsRequest, stream pb.PodManagerService_GetPodLogsServer) error {
-	log.Printf("Received GetPodLogs request for %s/%s", req.Namespace, req.PodName)
-
-	// 模拟日志数据
-	logs := []string{
-		"Starting container...",
-		"Container started successfully",
-		"Application initialized",
-		"Listening on port 8080",
-	}
-
-	// 流式发送日志
-	for _, line := range logs {
-		if err := stream.Send(&pb.LogChunk{
-			Content: []byte(line + "\n"),
-		}); err != nil {
-			return err
+	logger.L().Info("GetPodLogs called", zap.String("request", req.String()))
+	if req.GetNamespace() == "" || req.GetPodName() == "" {
+		return status.Errorf(codes.InvalidArgument, "namespace and pod_name are required")
+	}
+
+	clientset, err := newClientset()
+	if err != nil {
+		return err
+	}
+
+	// 始终向 kubelet 请求时间戳，用于填充 LogChunk.timestamp；
+	// 客户端未要求 timestamps 时再从内容中去掉前缀
+	opts := &corev1.PodLogOptions{
+		Container:  req.GetContainer(),
+		Follow:     req.GetFollow(),
+		Previous:   req.GetPrevious(),
+		Timestamps: true,
+	}
+	if req.GetSinceSeconds() > 0 {
+		sinceSeconds := req.GetSinceSeconds()
+		opts.SinceSeconds = &sinceSeconds
+	}
+	if req.GetTailLines() > 0 {
+		tailLines := req.GetTailLines()
+		opts.TailLines = &tailLines
+	}
+
+	ctx := stream.Context()
+	logStream, err := clientset.CoreV1().Pods(req.GetNamespace()).GetLogs(req.GetPodName(), opts).Stream(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		logger.L().Error("Failed to open pod log stream", zap.String("pod", req.GetPodName()), zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to stream logs of pod %s/%s: %v", req.GetNamespace(), req.GetPodName(), err)
+	}
+	defer logStream.Close()
+
+	reader := bufio.NewReader(logStream)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			chunk := parseLogLine(line, req.GetTimestamps())
+			if err := stream.Send(chunk); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+		if readErr != nil {
+			// 客户端断开或日志流结束都视为正常结束
+			if readErr == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			logger.L().Error("Failed to read pod log stream", zap.String("pod", req.GetPodName()), zap.Error(readErr))
+			return status.Errorf(codes.Internal, "failed to read logs of pod %s/%s: %v", req.GetNamespace(), req.GetPodName(), readErr)
 		}
-		time.Sleep(500 * time.Millisecond) // 模拟日志间隔
 	}
+}
 
-	return nil
+// parseLogLine 解析 kubelet 输出的 "<RFC3339Nano> <content>" 格式日志行
+func parseLogLine(line []byte, keepTimestamp bool) *pb.LogChunk {
+	chunk := &pb.LogChunk{Content: line}
+	idx := bytes.IndexByte(line, ' ')
+	if idx <= 0 {
+		return chunk
+	}
+	ts, err := time.Parse(time.RFC3339Nano, string(line[:idx]))
+	if err != nil {
+		return chunk
+	}
+	chunk.Timestamp = timestamppb.New(ts)
+	if !keepTimestamp {
+		chunk.Content = line[idx+1:]
+	}
+	return chunk
+}
+
+// newClientset 优先使用集群内配置，失败时回退到本地 kubeconfig
+func newClientset() (*kubernetes.Clientset, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to build kubeconfig: %v", err)
+		}
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create Kubernetes client: %v", err)
+	}
+	return clientset, nil
 }
 
 // ConfigureHorizontalAutoscaling 实现配置 HPA 的 RPC 方法
@@ -0,0 +1,36 @@
+package pod
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb_pod"
+	"jos-deployment/pkg/terminal"
+)
+
+// ExecPodTerminal 实现进入 Pod 终端的双向流 RPC 方法
+func (s *PodManagerServer) ExecPodTerminal(stream pb.PodManagerService_ExecPodTerminalServer) error {
+	return s.serveTerminal(stream.Context(), stream)
+}
+
+func (s *PodManagerServer) terminal() (*terminal.Terminal, error) {
+	if s.Terminal != nil {
+		return s.Terminal, nil
+	}
+	t, err := terminal.New()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create terminal: %v", err)
+	}
+	return t, nil
+}
+
+// serveTerminal 会话流程见 terminal.Serve
+func (s *PodManagerServer) serveTerminal(ctx context.Context, stream terminal.Stream) error {
+	term, err := s.terminal()
+	if err != nil {
+		return err
+	}
+	return term.Serve(ctx, stream, s.TerminalIdleTimeout)
+}
@@ -0,0 +1,145 @@
+package pod
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb_pod"
+	"jos-deployment/pkg/logger"
+)
+
+// 浏览器终端（xterm.js）与服务端之间的帧。控制消息为 JSON 文本帧，容器输出为二进制帧，
+// 内容是原始字节：输出按读取的块发送，多字节 UTF-8 字符可能被拆到两帧中，客户端需要直接把
+// 字节交给 xterm.js（term.write(new Uint8Array(ev.data))），不能逐帧解码为字符串
+//
+//	客户端 -> 服务端: {"type":"input","data":"ls\r"} / {"type":"resize","cols":120,"rows":40}，
+//	                 二进制帧按原样作为输入
+//	服务端 -> 客户端: 二进制帧（输出） / {"type":"exit","code":0,"reason":""}
+type terminalFrame struct {
+	Type   string `json:"type"`
+	Data   string `json:"data,omitempty"`
+	Cols   uint32 `json:"cols,omitempty"`
+	Rows   uint32 `json:"rows,omitempty"`
+	Code   int32  `json:"code"`
+	Reason string `json:"reason,omitempty"`
+}
+
+var terminalUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	CheckOrigin:     checkTerminalOrigin,
+}
+
+// checkTerminalOrigin 只接受同源页面以及 TERMINAL_ALLOWED_ORIGINS（逗号分隔，* 表示任意来源）
+// 中列出的来源，防止其他站点借用浏览器中的 token 打开终端。没有 Origin 头的非浏览器客户端不受限制
+func checkTerminalOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range strings.Split(os.Getenv("TERMINAL_ALLOWED_ORIGINS"), ",") {
+		allowed = strings.TrimSuffix(strings.TrimSpace(allowed), "/")
+		if allowed == "*" || (allowed != "" && strings.EqualFold(allowed, origin)) {
+			return true
+		}
+	}
+	logger.L().Warn("Terminal websocket origin rejected", zap.String("origin", origin), zap.String("host", r.Host))
+	return false
+}
+
+// ServeTerminalWebSocket 将浏览器 WebSocket 连接接入与 ExecPodTerminal 相同的 exec 流程
+func (s *PodManagerServer) ServeTerminalWebSocket(w http.ResponseWriter, r *http.Request) {
+	info := &pb.TerminalSessionInfo{
+		Namespace: r.PathValue("namespace"),
+		PodName:   r.PathValue("pod_name"),
+		Container: r.URL.Query().Get("container"),
+		Command:   r.URL.Query()["command"],
+	}
+	if info.Namespace == "" || info.PodName == "" {
+		http.Error(w, "namespace and pod_name are required", http.StatusBadRequest)
+		return
+	}
+
+	conn, err := terminalUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.L().Error("Failed to upgrade terminal websocket", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	stream := &wsTerminalStream{conn: conn, info: info}
+	if err := s.serveTerminal(r.Context(), stream); err != nil {
+		logger.L().Error("Terminal websocket session failed", zap.String("pod", info.PodName), zap.Error(err))
+		stream.Send(&pb.TerminalMessage{Payload: &pb.TerminalMessage_Exit{Exit: &pb.TerminalExit{
+			ExitCode: -1,
+			Reason:   status.Convert(err).Message(),
+		}}})
+	}
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// wsTerminalStream 把 WebSocket 帧适配为 terminal.Stream，会话信息来自 URL
+type wsTerminalStream struct {
+	conn     *websocket.Conn
+	info     *pb.TerminalSessionInfo
+	infoSent bool
+}
+
+func (s *wsTerminalStream) Recv() (*pb.TerminalMessage, error) {
+	if !s.infoSent {
+		s.infoSent = true
+		return &pb.TerminalMessage{Payload: &pb.TerminalMessage_SessionInfo{SessionInfo: s.info}}, nil
+	}
+	for {
+		messageType, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+		if messageType == websocket.BinaryMessage {
+			return &pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: data}}, nil
+		}
+		var frame terminalFrame
+		if err := json.Unmarshal(data, &frame); err != nil {
+			return nil, err
+		}
+		switch frame.Type {
+		case "input":
+			return &pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: []byte(frame.Data)}}, nil
+		case "resize":
+			return &pb.TerminalMessage{Payload: &pb.TerminalMessage_Resize{Resize: &pb.Resize{
+				Width:  frame.Cols,
+				Height: frame.Rows,
+			}}}, nil
+		default:
+			// 忽略心跳等未知帧
+		}
+	}
+}
+
+func (s *wsTerminalStream) Send(msg *pb.TerminalMessage) error {
+	var frame terminalFrame
+	switch payload := msg.GetPayload().(type) {
+	case *pb.TerminalMessage_Data:
+		return s.conn.WriteMessage(websocket.BinaryMessage, payload.Data)
+	case *pb.TerminalMessage_Exit:
+		frame = terminalFrame{Type: "exit", Code: payload.Exit.GetExitCode(), Reason: payload.Exit.GetReason()}
+	default:
+		return fmt.Errorf("unsupported terminal message %T", payload)
+	}
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
@@ -0,0 +1,107 @@
+package pod
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	pb "jos-deployment/api/v1alpha1/pb_pod"
+)
+
+// dialTerminalStream 建立 WebSocket 连接，serve 在服务端使用 wsTerminalStream
+func dialTerminalStream(t *testing.T, serve func(*wsTerminalStream)) *websocket.Conn {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := terminalUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade: %v", err)
+			return
+		}
+		defer conn.Close()
+		serve(&wsTerminalStream{conn: conn, info: &pb.TerminalSessionInfo{Namespace: "default", PodName: "web-0"}})
+	}))
+	t.Cleanup(srv.Close)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestWSTerminalStreamSendKeepsSplitUTF8(t *testing.T) {
+	output := []byte("你好\n")
+	conn := dialTerminalStream(t, func(s *wsTerminalStream) {
+		// 在多字节字符中间拆分输出
+		for _, chunk := range [][]byte{output[:1], output[1:4], output[4:]} {
+			if err := s.Send(&pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: chunk}}); err != nil {
+				t.Errorf("Send: %v", err)
+				return
+			}
+		}
+		s.Send(&pb.TerminalMessage{Payload: &pb.TerminalMessage_Exit{Exit: &pb.TerminalExit{ExitCode: 0}}})
+	})
+
+	var got []byte
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("ReadMessage: %v", err)
+		}
+		if messageType == websocket.BinaryMessage {
+			got = append(got, data...)
+			continue
+		}
+		var frame terminalFrame
+		if err := json.Unmarshal(data, &frame); err != nil || frame.Type != "exit" {
+			t.Fatalf("unexpected text frame %s", data)
+		}
+		break
+	}
+	if !bytes.Equal(got, output) {
+		t.Errorf("output = %q, want %q", got, output)
+	}
+}
+
+func TestWSTerminalStreamRecv(t *testing.T) {
+	received := make(chan []*pb.TerminalMessage, 1)
+	conn := dialTerminalStream(t, func(s *wsTerminalStream) {
+		var msgs []*pb.TerminalMessage
+		for i := 0; i < 4; i++ {
+			msg, err := s.Recv()
+			if err != nil {
+				t.Errorf("Recv: %v", err)
+				break
+			}
+			msgs = append(msgs, msg)
+		}
+		received <- msgs
+	})
+
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"resize","cols":120,"rows":40}`))
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"ping"}`))
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"input","data":"ls\r"}`))
+	conn.WriteMessage(websocket.BinaryMessage, []byte{0x1b, '[', 'A'})
+
+	msgs := <-received
+	if len(msgs) != 4 {
+		t.Fatalf("received %d messages", len(msgs))
+	}
+	if info := msgs[0].GetSessionInfo(); info.GetPodName() != "web-0" {
+		t.Errorf("first message = %v, want session info", msgs[0])
+	}
+	if resize := msgs[1].GetResize(); resize.GetWidth() != 120 || resize.GetHeight() != 40 {
+		t.Errorf("resize = %v", msgs[1])
+	}
+	if data := msgs[2].GetData(); string(data) != "ls\r" {
+		t.Errorf("text input = %q", data)
+	}
+	if data := msgs[3].GetData(); !bytes.Equal(data, []byte{0x1b, '[', 'A'}) {
+		t.Errorf("binary input = %q", data)
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	podpb "jos-deployment/api/v1alpha1/pb_pod"
+	"jos-deployment/handler/helm"
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/rbac"
+)
+
+// RBACInterceptor 位于 JWT 拦截器和处理函数之间，按请求的方法和命名空间做授权。
+// authorizer 为 nil 时不做授权
+type RBACInterceptor struct {
+	authorizer *rbac.Authorizer
+}
+
+func NewRBACInterceptor(authorizer *rbac.Authorizer) *RBACInterceptor {
+	return &RBACInterceptor{authorizer: authorizer}
+}
+
+// requestNamespace 读取请求中的命名空间，请求没有命名空间字段时 namespaced 为 false。
+// 异步操作接口只有操作ID，使用操作记录的命名空间；终端会话的命名空间在第一条消息的会话信息中；
+// 安装类请求指定了工作空间时使用对应的托管命名空间
+func requestNamespace(req interface{}) (namespace string, namespaced bool) {
+	if scope := helm.RequestScope(req); scope != nil {
+		return helm.WorkspaceNamespace(scope), true
+	}
+	switch r := req.(type) {
+	case interface{ GetNamespace() string }:
+		namespace = r.GetNamespace()
+	case *podpb.TerminalMessage:
+		namespace = r.GetSessionInfo().GetNamespace()
+	case *pb.GetOperationRequest:
+		namespace = helm.OperationNamespace(r.GetOperationId())
+	case *pb.CancelOperationRequest:
+		namespace = helm.OperationNamespace(r.GetOperationId())
+	case *pb.EnsureWorkspaceNamespaceRequest:
+		namespace = helm.WorkspaceNamespace(r)
+	default:
+		return "", false
+	}
+	// ListInstalledCharts 用 all 表示全部命名空间
+	if namespace == "all" {
+		namespace = ""
+	}
+	return namespace, true
+}
+
+// authorize 未认证（免认证列表中）的请求和权限检查接口本身不做授权；列出全部命名空间的
+// 接口由处理函数按命名空间过滤结果
+func (i *RBACInterceptor) authorize(ctx context.Context, method, namespace string) error {
+	if i.authorizer == nil || method == pb.HelmManagerService_CheckAccess_FullMethodName ||
+		(namespace == "" && helm.AllNamespacesMethods[method]) {
+		return nil
+	}
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	decision, err := i.authorizer.Authorize(identity, method, namespace)
+	if err != nil {
+		logger.L().Error("RBAC check failed", zap.String("user_id", identity.UserID), zap.String("method", method), zap.Error(err))
+		return status.Errorf(codes.Unavailable, "authorization unavailable: %v", err)
+	}
+	if !decision.Allowed {
+		logger.L().Warn("Request denied by RBAC",
+			zap.String("user_id", identity.UserID),
+			zap.String("method", method),
+			zap.String("namespace", namespace),
+			zap.String("reason", decision.Reason))
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s", decision.Reason)
+	}
+	return nil
+}
+
+// Interceptor 实现 gRPC 一元拦截器接口
+func (i *RBACInterceptor) Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		namespace, _ := requestNamespace(req)
+		if err := i.authorize(ctx, info.FullMethod, namespace); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor 实现 gRPC 流拦截器接口。命名空间在请求消息中，授权推迟到处理函数
+// 读取第一条消息时进行
+func (i *RBACInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if i.authorizer == nil {
+			return handler(srv, ss)
+		}
+		return handler(srv, &checkedStream{ServerStream: ss, check: func(m interface{}) error {
+			namespace, _ := requestNamespace(m)
+			return i.authorize(ss.Context(), info.FullMethod, namespace)
+		}})
+	}
+}
+
+// checkedStream 在处理函数读取第一条消息时执行 check，失败时把错误返回给处理函数
+type checkedStream struct {
+	grpc.ServerStream
+	check   func(m interface{}) error
+	checked bool
+}
+
+func (s *checkedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.checked {
+		if err := s.check(m); err != nil {
+			return err
+		}
+		s.checked = true
+	}
+	return nil
+}
+
+// HTTPMiddleware 为不经过 grpc-gateway 的路由授权，method 为路由对应的 gRPC 方法，
+// 命名空间取自路由中的 {namespace}
+func (i *RBACInterceptor) HTTPMiddleware(method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := i.authorize(r.Context(), method, r.PathValue("namespace")); err != nil {
+			writeHTTPError(w, http.StatusForbidden, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/status"
+)
+
+// HTTPMiddleware 为不经过 grpc-gateway 的路由（chart 上传、终端 WebSocket 等）做认证，
+// 与 gRPC 拦截器使用相同的 token 校验和免认证列表。浏览器建立 WebSocket 时无法设置
+// Authorization 头，此时可通过 access_token 查询参数传递 token
+func (i *JWTInterceptor) HTTPMiddleware(next http.Handler) http.Handler {
+	return i.httpMiddleware(next, false)
+}
+
+// ChartRepoMiddleware 内置 chart 仓库的认证。helm repo add 只支持 Basic 认证，因此除 Bearer 外
+// 也接受以 token 为密码的 Basic 认证，用户名不做校验：
+//
+//	helm repo add jos https://<host>/chartrepo --username jos --password <token>
+func (i *JWTInterceptor) ChartRepoMiddleware(next http.Handler) http.Handler {
+	return i.httpMiddleware(next, true)
+}
+
+func (i *JWTInterceptor) httpMiddleware(next http.Handler, basic bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i.allowed(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, password, ok := r.BasicAuth(); basic && ok {
+			tokenString = password
+		}
+		if tokenString == "" && isWebSocketUpgrade(r) {
+			tokenString = r.URL.Query().Get("access_token")
+		}
+		ctx, err := i.authenticate(r.Context(), tokenString, r.URL.Path)
+		if err != nil {
+			if basic {
+				w.Header().Add("WWW-Authenticate", `Basic realm="chartrepo"`)
+			}
+			writeHTTPError(w, http.StatusUnauthorized, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// writeHTTPError 返回与 grpc-gateway 错误相同结构的 JSON
+func writeHTTPError(w http.ResponseWriter, statusCode int, err error) {
+	st := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	if statusCode == http.StatusUnauthorized {
+		w.Header().Add("WWW-Authenticate", "Bearer")
+	}
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":    int32(st.Code()),
+		"message": st.Message(),
+	})
+}
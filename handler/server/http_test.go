@@ -0,0 +1,93 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"jos-deployment/pkg/auth"
+)
+
+func newTestInterceptor(t *testing.T, secret []byte) *JWTInterceptor {
+	t.Helper()
+	verifier, err := auth.NewVerifier(auth.Config{HMACSecrets: [][]byte{secret}})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	return NewJWTInterceptor(verifier, []string{"/healthz"})
+}
+
+func TestChartRepoMiddlewareBasicAuth(t *testing.T) {
+	secret := []byte("test-secret")
+	i := newTestInterceptor(t, secret)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "42",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(secret)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	var gotUser string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := auth.FromContext(r.Context()); ok {
+			gotUser = id.UserID
+		}
+	})
+
+	tests := []struct {
+		name       string
+		middleware func(http.Handler) http.Handler
+		setAuth    func(r *http.Request)
+		wantStatus int
+	}{
+		{
+			name:       "basic auth with token as password",
+			middleware: i.ChartRepoMiddleware,
+			setAuth:    func(r *http.Request) { r.SetBasicAuth("jos", token) },
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "bearer token",
+			middleware: i.ChartRepoMiddleware,
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) },
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "basic auth with invalid password",
+			middleware: i.ChartRepoMiddleware,
+			setAuth:    func(r *http.Request) { r.SetBasicAuth("jos", "not-a-token") },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "no credentials",
+			middleware: i.ChartRepoMiddleware,
+			setAuth:    func(*http.Request) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "basic auth on other routes",
+			middleware: i.HTTPMiddleware,
+			setAuth:    func(r *http.Request) { r.SetBasicAuth("jos", token) },
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUser = ""
+			r := httptest.NewRequest(http.MethodGet, "/chartrepo/index.yaml", nil)
+			tt.setAuth(r)
+			w := httptest.NewRecorder()
+			tt.middleware(next).ServeHTTP(w, r)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantStatus == http.StatusOK && gotUser != "42" {
+				t.Errorf("identity user = %q, want 42", gotUser)
+			}
+		})
+	}
+}
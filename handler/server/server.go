@@ -9,72 +9,155 @@ import (
 	"jos-deployment/handler/helm"
 	"jos-deployment/handler/pod"
 	"jos-deployment/handler/routes"
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/logger"
 	"log"
 	"net"
+	"os"
 	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// defaultAuthAllowlist 默认免认证的路径：健康检查和监控指标。内置 chart 仓库在网关上需要认证，
+// HelmClient 通过仅本机可访问的监听地址拉取 chart，见 helm.InitLocalRepo
+const defaultAuthAllowlist = "/healthz,/readyz,/metrics,/grpc.health.v1.Health/*"
+
 // JWTInterceptor 结构体封装 JWT 拦截器相关配置
 type JWTInterceptor struct {
-	// 可以在此添加配置项，如白名单路径等
+	verifier *auth.Verifier
+	// allowlist 免认证的 gRPC 方法全名或 HTTP 路径，以 * 结尾时按前缀匹配
+	allowlist []string
 }
 
 // NewJWTInterceptor 创建新的 JWT 拦截器实例
-func NewJWTInterceptor() *JWTInterceptor {
-	return &JWTInterceptor{}
+func NewJWTInterceptor(verifier *auth.Verifier, allowlist []string) *JWTInterceptor {
+	return &JWTInterceptor{verifier: verifier, allowlist: allowlist}
+}
+
+// NewJWTInterceptorFromEnv 使用 auth.ConfigFromEnv 的校验配置创建拦截器，免认证列表读取
+// 逗号分隔的 AUTH_ALLOWLIST，未设置时使用 defaultAuthAllowlist
+func NewJWTInterceptorFromEnv() (*JWTInterceptor, error) {
+	verifier, err := auth.NewVerifierFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	list, ok := os.LookupEnv("AUTH_ALLOWLIST")
+	if !ok {
+		list = defaultAuthAllowlist
+	}
+	var allowlist []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			allowlist = append(allowlist, entry)
+		}
+	}
+	return NewJWTInterceptor(verifier, allowlist), nil
+}
+
+// allowed 判断 gRPC 方法或 HTTP 路径是否免认证
+func (i *JWTInterceptor) allowed(name string) bool {
+	for _, entry := range i.allowlist {
+		if prefix, ok := strings.CutSuffix(entry, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate 校验 token 的签名及 exp、nbf、iss、aud，并将调用方身份存入上下文，
+// 后续通过 auth.FromContext 读取
+func (i *JWTInterceptor) authenticate(ctx context.Context, tokenString, target string) (context.Context, error) {
+	if tokenString == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	identity, err := i.verifier.Verify(ctx, tokenString)
+	if err != nil {
+		logger.L().Warn("JWT verification failed", zap.String("target", target), zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+	}
+	return auth.NewContext(ctx, identity), nil
+}
+
+// authenticateIncoming 从 gRPC 元数据的 Authorization 头中提取 Bearer token 并校验
+func (i *JWTInterceptor) authenticateIncoming(ctx context.Context, method string) (context.Context, error) {
+	// 1. 从上下文中获取元数据
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	// 2. 检查并获取 Authorization 头
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	// 3. 提取 Bearer token 并校验
+	return i.authenticate(ctx, strings.TrimPrefix(authHeaders[0], "Bearer "), method)
 }
 
 // Interceptor 实现 gRPC 一元拦截器接口
 func (i *JWTInterceptor) Interceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// 1. 从上下文中获取元数据
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Code(10401), "missing metadata")
+		if i.allowed(info.FullMethod) {
+			return handler(ctx, req)
 		}
-
-		// 2. 检查并获取 Authorization 头
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Code(10401), "missing metadata")
+		ctx, err := i.authenticateIncoming(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// 3. 提取 Bearer token
-		tokenString := strings.TrimPrefix(authHeaders[0], "Bearer ")
-		if tokenString == "" {
-			return nil, status.Error(codes.Code(10401), "missing metadata")
+// StreamInterceptor 实现 gRPC 流拦截器接口，覆盖 GetPodLogs、ExecPodTerminal、
+// WatchInstallStatus、UploadChart 等流式接口
+func (i *JWTInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if i.allowed(info.FullMethod) {
+			return handler(srv, ss)
 		}
-
-		// 4. 创建不验证签名的 JWT 解析器
-		parser := jwt.NewParser(
-			jwt.WithoutClaimsValidation(),
-			jwt.WithValidMethods([]string{"HS256", "HS384", "HS512", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
-		)
-
-		// 5. 解析但不验证 JWT token
-		token, _, err := parser.ParseUnverified(tokenString, jwt.MapClaims{})
+		ctx, err := i.authenticateIncoming(ss.Context(), info.FullMethod)
 		if err != nil {
-			return nil, status.Error(codes.Code(10401), fmt.Sprintf("invalid token format: %v", err))
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// 6. 将解析后的 token 存入上下文，供后续处理使用
-		ctx = context.WithValue(ctx, "jwtToken", token)
+// authenticatedStream 替换流的上下文，使处理函数能读取调用方身份
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		// 7. 调用后续处理程序
-		return handler(ctx, req)
-	}
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
 
-func Server() {
-	// 创建拦截器实例
-	jwtInterceptor := NewJWTInterceptor()
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(jwtInterceptor.Interceptor()))
+func Server(jwtInterceptor *JWTInterceptor, rbacInterceptor *RBACInterceptor, tenancyInterceptor *TenancyInterceptor) {
+	// 先认证，再按角色授权，最后校验工作空间
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			jwtInterceptor.Interceptor(),
+			rbacInterceptor.Interceptor(),
+			tenancyInterceptor.Interceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			jwtInterceptor.StreamInterceptor(),
+			rbacInterceptor.StreamInterceptor(),
+			tenancyInterceptor.StreamInterceptor(),
+		),
+	)
 	pb.RegisterHelmManagerServiceServer(grpcServer, &helm.HelmManagerServer{})
 	podpb.RegisterPodManagerServiceServer(grpcServer, &pod.PodManagerServer{})
 	routepb.RegisterAPISIXGatewayServiceServer(grpcServer, &routes.RoutesManageService{})
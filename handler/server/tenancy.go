@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb"
+	"jos-deployment/handler/helm"
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/tenancy"
+)
+
+// TenancyInterceptor 限制调用方只能访问所属工作空间的托管命名空间。manager 为 nil 时不做限制
+type TenancyInterceptor struct {
+	manager *tenancy.Manager
+}
+
+func NewTenancyInterceptor(manager *tenancy.Manager) *TenancyInterceptor {
+	return &TenancyInterceptor{manager: manager}
+}
+
+// check 没有命名空间字段的请求不受限制；EnsureWorkspaceNamespace 和指定了工作空间的安装类请求
+// 在处理函数中按工作空间校验（命名空间可能尚未创建），列出全部命名空间的接口由处理函数过滤结果
+func (i *TenancyInterceptor) check(ctx context.Context, method string, req interface{}) error {
+	if i.manager == nil ||
+		method == pb.HelmManagerService_CheckAccess_FullMethodName ||
+		method == pb.HelmManagerService_EnsureWorkspaceNamespace_FullMethodName ||
+		helm.RequestScope(req) != nil {
+		return nil
+	}
+	namespace, namespaced := requestNamespace(req)
+	if !namespaced || (namespace == "" && helm.AllNamespacesMethods[method]) {
+		return nil
+	}
+	return i.checkNamespace(ctx, method, namespace)
+}
+
+// checkNamespace 未认证（免认证列表中）的请求不做限制
+func (i *TenancyInterceptor) checkNamespace(ctx context.Context, method, namespace string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	err := i.manager.CheckNamespace(ctx, identity, namespace)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, tenancy.ErrForbidden) {
+		logger.L().Warn("Request denied by workspace isolation",
+			zap.String("user_id", identity.UserID),
+			zap.String("method", method),
+			zap.String("namespace", namespace),
+			zap.Error(err))
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "check namespace access failed: %v", err)
+}
+
+// Interceptor 实现 gRPC 一元拦截器接口
+func (i *TenancyInterceptor) Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.check(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor 实现 gRPC 流拦截器接口，在处理函数读取第一条消息时校验
+func (i *TenancyInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if i.manager == nil {
+			return handler(srv, ss)
+		}
+		return handler(srv, &checkedStream{ServerStream: ss, check: func(m interface{}) error {
+			return i.check(ss.Context(), info.FullMethod, m)
+		}})
+	}
+}
+
+// HTTPMiddleware 校验路由中的 {namespace}，路由没有命名空间时不做限制
+func (i *TenancyInterceptor) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i.manager != nil && r.PathValue("namespace") != "" {
+			if err := i.checkNamespace(r.Context(), r.URL.Path, r.PathValue("namespace")); err != nil {
+				writeHTTPError(w, runtime.HTTPStatusFromCode(status.Code(err)), err)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type identityKey struct{}
+
+// Identity 从校验通过的 JWT 中提取的调用方身份
+type Identity struct {
+	UserID string
+	Tenant string
+	Roles  []string
+	// Claims 原始 claims，供需要额外字段的调用方使用
+	Claims jwt.MapClaims
+}
+
+// HasRole 判断调用方是否拥有指定角色
+func (i *Identity) HasRole(role string) bool {
+	for _, r := range i.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext 返回携带调用方身份的 context
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext 读取拦截器放入 context 的调用方身份，未认证的请求返回 false
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok && id != nil
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	// jwksMinRefetch 两次拉取的最小间隔，防止伪造 kid 或端点故障时每个请求都去拉取
+	jwksMinRefetch = 30 * time.Second
+	jwksTimeout    = 10 * time.Second
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwksCache 缓存 JWKS 端点返回的公钥。缓存超过 refresh 后重新拉取，签发方轮换密钥后
+// 出现未知 kid 时立即拉取；拉取失败时继续使用旧的公钥
+type jwksCache struct {
+	url     string
+	refresh time.Duration
+	client  *http.Client
+	// group 保证同一时间只有一个请求在拉取，其他请求等待同一次拉取的结果
+	group singleflight.Group
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	fetching    bool
+}
+
+func newJWKSCache(url string, refresh time.Duration) *jwksCache {
+	return &jwksCache{
+		url:     url,
+		refresh: refresh,
+		client:  &http.Client{Timeout: jwksTimeout},
+	}
+}
+
+// Keys 返回 kid 对应的公钥，kid 为空时返回全部公钥。需要拉取时等待拉取完成，
+// ctx 取消时不再等待并使用当前缓存，拉取本身不受影响
+func (c *jwksCache) Keys(ctx context.Context, kid string) []crypto.PublicKey {
+	if c.needsFetch(kid) {
+		ch := c.group.DoChan(c.url, func() (interface{}, error) {
+			c.update()
+			return nil, nil
+		})
+		select {
+		case <-ch:
+		case <-ctx.Done():
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if kid != "" {
+		if key, ok := c.keys[kid]; ok {
+			return []crypto.PublicKey{key}
+		}
+		return nil
+	}
+	keys := make([]crypto.PublicKey, 0, len(c.keys))
+	for _, key := range c.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (c *jwksCache) needsFetch(kid string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	_, known := c.keys[kid]
+	stale := now.Sub(c.fetchedAt) > c.refresh
+	if !stale && (kid == "" || known) {
+		return false
+	}
+	// 正在拉取时加入该次拉取，否则受最小间隔限制
+	return c.fetching || now.Sub(c.lastAttempt) > jwksMinRefetch
+}
+
+// update 拉取并替换缓存的公钥。拉取不持有锁，使用独立的 context，不随触发拉取的请求取消
+func (c *jwksCache) update() {
+	c.mu.Lock()
+	if time.Since(c.lastAttempt) <= jwksMinRefetch {
+		// 上一次拉取刚结束
+		c.mu.Unlock()
+		return
+	}
+	c.lastAttempt, c.fetching = time.Now(), true
+	c.mu.Unlock()
+
+	keys, err := c.fetch(context.Background())
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetching = false
+	if err != nil {
+		logger.L().Warn("Failed to fetch JWKS", zap.String("url", c.url), zap.Error(err))
+		return
+	}
+	c.keys, c.fetchedAt = keys, time.Now()
+}
+
+func (c *jwksCache) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, jwksTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for i, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			logger.L().Warn("Skipping unsupported JWK", zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		kid := jwk.Kid
+		if kid == "" {
+			kid = fmt.Sprintf("#%d", i)
+		}
+		keys[kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no usable signing keys")
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("decode n: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("decode e: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode x: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("decode y: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,286 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultJWKSRefresh = 15 * time.Minute
+	defaultLeeway      = 30 * time.Second
+)
+
+// Config JWT 校验配置，HMACSecrets、PublicKeys、JWKSURL 至少配置一项。
+// 多个 HMAC 密钥或公钥用于密钥轮换期间新旧密钥同时有效
+type Config struct {
+	HMACSecrets [][]byte
+	PublicKeys  []crypto.PublicKey
+	JWKSURL     string
+	// JWKSRefresh JWKS 缓存的有效期
+	JWKSRefresh time.Duration
+
+	// Issuer、Audience 非空时要求 token 的 iss、aud 与之匹配
+	Issuer   string
+	Audience string
+	// Leeway 校验 exp、nbf 时允许的时钟偏差
+	Leeway time.Duration
+
+	// 构造 Identity 时读取的 claim 名称
+	UserClaim   string
+	TenantClaim string
+	RolesClaim  string
+}
+
+// ConfigFromEnv 从环境变量读取校验配置：
+//   - JWT_HMAC_SECRETS: 逗号分隔的 HMAC 密钥
+//   - JWT_PUBLIC_KEYS_FILE: PEM 文件，可包含多个 RSA/ECDSA 公钥或证书
+//   - JWT_JWKS_URL / JWT_JWKS_REFRESH: JWKS 地址及缓存有效期，默认 15m
+//   - JWT_ISSUER / JWT_AUDIENCE / JWT_LEEWAY: iss、aud 要求及时钟偏差，默认 30s
+//   - JWT_USER_CLAIM / JWT_TENANT_CLAIM / JWT_ROLES_CLAIM: 默认 sub、tenant、roles
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		JWKSURL:     os.Getenv("JWT_JWKS_URL"),
+		JWKSRefresh: defaultJWKSRefresh,
+		Issuer:      os.Getenv("JWT_ISSUER"),
+		Audience:    os.Getenv("JWT_AUDIENCE"),
+		Leeway:      defaultLeeway,
+		UserClaim:   envOrDefault("JWT_USER_CLAIM", "sub"),
+		TenantClaim: envOrDefault("JWT_TENANT_CLAIM", "tenant"),
+		RolesClaim:  envOrDefault("JWT_ROLES_CLAIM", "roles"),
+	}
+	for _, secret := range strings.Split(os.Getenv("JWT_HMAC_SECRETS"), ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			cfg.HMACSecrets = append(cfg.HMACSecrets, []byte(secret))
+		}
+	}
+	if path := os.Getenv("JWT_PUBLIC_KEYS_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("read JWT_PUBLIC_KEYS_FILE: %w", err)
+		}
+		if cfg.PublicKeys, err = ParsePublicKeys(data); err != nil {
+			return cfg, fmt.Errorf("parse JWT_PUBLIC_KEYS_FILE: %w", err)
+		}
+	}
+	if v := os.Getenv("JWT_JWKS_REFRESH"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid JWT_JWKS_REFRESH %q", v)
+		}
+		cfg.JWKSRefresh = d
+	}
+	if v := os.Getenv("JWT_LEEWAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return cfg, fmt.Errorf("invalid JWT_LEEWAY %q", v)
+		}
+		cfg.Leeway = d
+	}
+	return cfg, nil
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// ParsePublicKeys 解析 PEM 格式的 RSA/ECDSA 公钥和证书，忽略其他类型的块
+func ParsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		var key crypto.PublicKey
+		switch block.Type {
+		case "PUBLIC KEY":
+			k, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case "RSA PUBLIC KEY":
+			k, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			key = cert.PublicKey
+		default:
+			continue
+		}
+		switch key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			keys = append(keys, key)
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no public keys found")
+	}
+	return keys, nil
+}
+
+// Verifier 校验 JWT 的签名和 exp、nbf、iss、aud，并从 claims 构造 Identity
+type Verifier struct {
+	cfg    Config
+	jwks   *jwksCache
+	parser *jwt.Parser
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	if len(cfg.HMACSecrets) == 0 && len(cfg.PublicKeys) == 0 && cfg.JWKSURL == "" {
+		return nil, errors.New("no JWT verification keys configured")
+	}
+	if cfg.JWKSRefresh <= 0 {
+		cfg.JWKSRefresh = defaultJWKSRefresh
+	}
+	if cfg.UserClaim == "" {
+		cfg.UserClaim = "sub"
+	}
+
+	v := &Verifier{cfg: cfg}
+	if cfg.JWKSURL != "" {
+		v.jwks = newJWKSCache(cfg.JWKSURL, cfg.JWKSRefresh)
+	}
+
+	// 只接受已配置密钥能够校验的算法，避免用公钥充当 HMAC 密钥的算法混淆
+	var methods []string
+	if len(cfg.HMACSecrets) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	hasRSA, hasEC := v.jwks != nil, v.jwks != nil
+	for _, key := range cfg.PublicKeys {
+		switch key.(type) {
+		case *rsa.PublicKey:
+			hasRSA = true
+		case *ecdsa.PublicKey:
+			hasEC = true
+		}
+	}
+	if hasRSA {
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512")
+	}
+	if hasEC {
+		methods = append(methods, "ES256", "ES384", "ES512")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+// NewVerifierFromEnv 使用 ConfigFromEnv 的配置创建 Verifier
+func NewVerifierFromEnv() (*Verifier, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(cfg)
+}
+
+// Verify 校验 token 并返回调用方身份
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keyFunc(ctx)); err != nil {
+		return nil, err
+	}
+	id := &Identity{
+		UserID: claimString(claims, v.cfg.UserClaim),
+		Tenant: claimString(claims, v.cfg.TenantClaim),
+		Roles:  claimStrings(claims, v.cfg.RolesClaim),
+		Claims: claims,
+	}
+	if id.UserID == "" {
+		return nil, fmt.Errorf("token has no %s claim", v.cfg.UserClaim)
+	}
+	return id, nil
+}
+
+func (v *Verifier) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		var keys []jwt.VerificationKey
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			for _, secret := range v.cfg.HMACSecrets {
+				keys = append(keys, secret)
+			}
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA:
+			_, wantEC := token.Method.(*jwt.SigningMethodECDSA)
+			kid, _ := token.Header["kid"].(string)
+			candidates := v.cfg.PublicKeys
+			if v.jwks != nil {
+				candidates = append(v.jwks.Keys(ctx, kid), candidates...)
+			}
+			for _, key := range candidates {
+				if _, isEC := key.(*ecdsa.PublicKey); isEC == wantEC {
+					keys = append(keys, key)
+				}
+			}
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no verification key for alg %s", token.Method.Alg())
+		}
+		return jwt.VerificationKeySet{Keys: keys}, nil
+	}
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	switch v := claims[name].(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%.0f", v)
+	default:
+		return ""
+	}
+}
+
+// claimStrings 读取字符串数组形式的 claim，也兼容以空格或逗号分隔的字符串
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	switch v := claims[name].(type) {
+	case []interface{}:
+		var out []string
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool { return r == ' ' || r == ',' })
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return key
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return s
+}
+
+// jwksServer 返回只包含 key 的 JWKS 端点，hits 记录被拉取的次数
+func jwksServer(t *testing.T, kid string, key *rsa.PublicKey, hits *atomic.Int32, delay time.Duration) *httptest.Server {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{"keys": []jsonWebKey{{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatalf("marshal JWKS: %v", err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits != nil {
+			hits.Add(1)
+		}
+		time.Sleep(delay)
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey := generateRSAKey(t)
+	jwksKey := generateRSAKey(t)
+	otherKey := generateRSAKey(t)
+	srv := jwksServer(t, "current", &jwksKey.PublicKey, nil, 0)
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+
+	verifier, err := NewVerifier(Config{
+		PublicKeys: []crypto.PublicKey{&rsaKey.PublicKey},
+		JWKSURL:    srv.URL,
+		Audience:   "jos-deployment",
+		Leeway:     time.Second,
+		UserClaim:  "sub",
+		RolesClaim: "roles",
+	})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+
+	now := time.Now()
+	claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"sub":   "42",
+			"aud":   "jos-deployment",
+			"exp":   now.Add(time.Hour).Unix(),
+			"roles": []string{"developer"},
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "RS256 signed with configured public key",
+			token: signToken(t, jwt.SigningMethodRS256, rsaKey, "", claims(nil)),
+		},
+		{
+			name:  "RS256 signed with JWKS key",
+			token: signToken(t, jwt.SigningMethodRS256, jwksKey, "current", claims(nil)),
+		},
+		{
+			name:    "HS256 signed with the RSA public key",
+			token:   signToken(t, jwt.SigningMethodHS256, pubPEM, "", claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "expired",
+			token:   signToken(t, jwt.SigningMethodRS256, rsaKey, "", claims(jwt.MapClaims{"exp": now.Add(-time.Minute).Unix()})),
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			token:   signToken(t, jwt.SigningMethodRS256, rsaKey, "", claims(jwt.MapClaims{"aud": "another-service"})),
+			wantErr: true,
+		},
+		{
+			name:    "unknown kid",
+			token:   signToken(t, jwt.SigningMethodRS256, otherKey, "rotated-out", claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "unsigned",
+			token:   signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "missing exp",
+			token:   signToken(t, jwt.SigningMethodRS256, rsaKey, "", claims(jwt.MapClaims{"exp": nil})),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Verify() accepted token, identity = %+v", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if id.UserID != "42" || !id.HasRole("developer") {
+				t.Errorf("identity = %+v", id)
+			}
+		})
+	}
+}
+
+func TestJWKSCacheSingleFetch(t *testing.T) {
+	key := generateRSAKey(t)
+	var hits atomic.Int32
+	srv := jwksServer(t, "current", &key.PublicKey, &hits, 100*time.Millisecond)
+	cache := newJWKSCache(srv.URL, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if keys := cache.Keys(context.Background(), "current"); len(keys) != 1 {
+				t.Errorf("Keys() returned %d keys", len(keys))
+			}
+		}()
+	}
+	wg.Wait()
+	if n := hits.Load(); n != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", n)
+	}
+}
+
+func TestJWKSCacheFetchSurvivesCancelledRequest(t *testing.T) {
+	key := generateRSAKey(t)
+	var hits atomic.Int32
+	srv := jwksServer(t, "current", &key.PublicKey, &hits, 100*time.Millisecond)
+	cache := newJWKSCache(srv.URL, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if keys := cache.Keys(ctx, "current"); len(keys) != 0 {
+		t.Fatalf("Keys() with cancelled context returned %d keys before the fetch finished", len(keys))
+	}
+	// 取消的请求触发的拉取在后台完成，随后的请求无需等待最小间隔即可拿到公钥
+	if keys := cache.Keys(context.Background(), "current"); len(keys) != 1 {
+		t.Fatalf("Keys() returned %d keys after the fetch", len(keys))
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", n)
+	}
+}
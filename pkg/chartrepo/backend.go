@@ -0,0 +1,80 @@
+package chartrepo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Backend chart 包和 index.yaml 的存储接口，对象名不包含目录
+type Backend interface {
+	Put(name string, r io.Reader) error
+	Get(name string) (io.ReadCloser, error)
+	Delete(name string) error
+	List() ([]string, error)
+}
+
+// FileBackend 把对象保存为本地目录下的文件
+type FileBackend struct {
+	dir string
+}
+
+func NewFileBackend(dir string) (*FileBackend, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create chart repository directory: %w", err)
+	}
+	return &FileBackend{dir: dir}, nil
+}
+
+// Put 先写入临时文件再重命名，读取方不会看到写了一半的文件
+func (b *FileBackend) Put(name string, r io.Reader) error {
+	tmp, err := os.CreateTemp(b.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(b.dir, name))
+}
+
+func (b *FileBackend) Get(name string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(b.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (b *FileBackend) Delete(name string) error {
+	err := os.Remove(filepath.Join(b.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (b *FileBackend) List() ([]string, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && entry.Name()[0] != '.' {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
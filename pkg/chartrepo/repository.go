@@ -0,0 +1,226 @@
+package chartrepo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	"jos-deployment/pkg/logger"
+)
+
+const indexFileName = "index.yaml"
+
+var ErrChartExists = errors.New("chart version already exists")
+
+// Repository 内置的 chart 仓库，chart 包保存在 Backend 中，index.yaml 随上传实时更新，
+// 通过 ServeHTTP 以 helm 经典仓库的格式对外提供
+type Repository struct {
+	backend Backend
+
+	mu        sync.RWMutex
+	index     *repo.IndexFile
+	indexData []byte
+}
+
+// New 加载 Backend 中保存的 index.yaml，不存在或损坏时根据 chart 包重新生成
+func New(backend Backend) (*Repository, error) {
+	r := &Repository{backend: backend}
+	index, err := r.loadIndex()
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			logger.L().Warn("Failed to load chart repository index, rebuilding", zap.Error(err))
+		}
+		return r, r.Rebuild()
+	}
+	return r, r.setIndex(index)
+}
+
+func (r *Repository) loadIndex() (*repo.IndexFile, error) {
+	rc, err := r.backend.Get(indexFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	index := repo.NewIndexFile()
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, err
+	}
+	if index.Entries == nil {
+		index.Entries = map[string]repo.ChartVersions{}
+	}
+	return index, nil
+}
+
+// Rebuild 根据 Backend 中的所有 chart 包重新生成 index.yaml，无法解析的包跳过
+func (r *Repository) Rebuild() error {
+	names, err := r.backend.List()
+	if err != nil {
+		return fmt.Errorf("list charts: %w", err)
+	}
+	index := repo.NewIndexFile()
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".tgz") {
+			continue
+		}
+		data, err := r.read(name)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", name, err)
+		}
+		if err := addToIndex(index, name, data); err != nil {
+			logger.L().Warn("Skipping invalid chart in repository", zap.String("file", name), zap.Error(err))
+		}
+	}
+	index.SortEntries()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.saveIndex(index)
+}
+
+// AddChart 保存 chart 包及可选的 .prov 文件并更新 index.yaml，同名同版本已存在时返回 ErrChartExists
+func (r *Repository) AddChart(data, prov []byte) (*repo.ChartVersion, error) {
+	ch, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("load chart archive: %w", err)
+	}
+	fileName := fmt.Sprintf("%s-%s.tgz", ch.Metadata.Name, ch.Metadata.Version)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.index.Has(ch.Metadata.Name, ch.Metadata.Version) {
+		return nil, fmt.Errorf("%w: %s", ErrChartExists, fileName)
+	}
+	if err := r.backend.Put(fileName, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("store %s: %w", fileName, err)
+	}
+	if len(prov) > 0 {
+		if err := r.backend.Put(fileName+".prov", bytes.NewReader(prov)); err != nil {
+			return nil, fmt.Errorf("store %s.prov: %w", fileName, err)
+		}
+	}
+
+	// 在副本上修改，保存失败时不影响正在提供的索引
+	index := repo.NewIndexFile()
+	for name, versions := range r.index.Entries {
+		index.Entries[name] = append(repo.ChartVersions(nil), versions...)
+	}
+	if err := addToIndex(index, fileName, data); err != nil {
+		return nil, err
+	}
+	index.SortEntries()
+	if err := r.saveIndex(index); err != nil {
+		return nil, err
+	}
+	cv, _ := index.Get(ch.Metadata.Name, ch.Metadata.Version)
+	return cv, nil
+}
+
+func (r *Repository) read(name string) ([]byte, error) {
+	rc, err := r.backend.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func addToIndex(index *repo.IndexFile, fileName string, data []byte) error {
+	ch, err := loader.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	digest, err := provenance.Digest(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	// baseURL 为空时 urls 为相对路径，helm 会基于仓库地址解析
+	return index.MustAdd(ch.Metadata, fileName, "", digest)
+}
+
+// saveIndex 持久化 index.yaml 并替换内存中的索引，调用方需持有写锁
+func (r *Repository) saveIndex(index *repo.IndexFile) error {
+	index.Generated = time.Now()
+	data, err := yaml.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("encode index: %w", err)
+	}
+	if err := r.backend.Put(indexFileName, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("store index: %w", err)
+	}
+	r.index, r.indexData = index, data
+	return nil
+}
+
+func (r *Repository) setIndex(index *repo.IndexFile) error {
+	data, err := yaml.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("encode index: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.index, r.indexData = index, data
+	return nil
+}
+
+// ServeHTTP 提供 /index.yaml 以及 chart 包和 .prov 文件的下载，路径前缀需由调用方去掉
+func (r *Repository) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(req.URL.Path, "/")
+	if name == "" || name == indexFileName {
+		r.serveIndex(w, req)
+		return
+	}
+	if strings.Contains(name, "/") || !(strings.HasSuffix(name, ".tgz") || strings.HasSuffix(name, ".tgz.prov")) {
+		http.NotFound(w, req)
+		return
+	}
+	rc, err := r.backend.Get(name)
+	if errors.Is(err, ErrNotFound) {
+		http.NotFound(w, req)
+		return
+	}
+	if err != nil {
+		logger.L().Error("Failed to read chart from repository", zap.String("file", name), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+	if strings.HasSuffix(name, ".prov") {
+		w.Header().Set("Content-Type", "application/pgp-signature")
+	} else {
+		w.Header().Set("Content-Type", "application/gzip")
+	}
+	if req.Method == http.MethodHead {
+		return
+	}
+	io.Copy(w, rc)
+}
+
+// serveIndex 带 ETag 和 Last-Modified，支持条件请求
+func (r *Repository) serveIndex(w http.ResponseWriter, req *http.Request) {
+	r.mu.RLock()
+	data, generated := r.indexData, r.index.Generated
+	r.mu.RUnlock()
+	w.Header().Set("Content-Type", "application/x-yaml")
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, sha256.Sum256(data)))
+	http.ServeContent(w, req, indexFileName, generated, bytes.NewReader(data))
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"errors"
 	"fmt"
 	"jos-deployment/pkg/model"
 	"log"
@@ -80,6 +81,11 @@ func initJosDB() error {
 	return nil
 }
 
+// InitSqliteDB 只初始化本地 sqlite 数据库（异步操作记录等不依赖 MySQL 的数据）
+func InitSqliteDB(path string) error {
+	return initSqliteDB(path)
+}
+
 // 初始化数据库连接
 func initSqliteDB(dbPath string) error {
 	// 确保目录存在
@@ -104,7 +110,7 @@ func initSqliteDB(dbPath string) error {
 	}
 
 	// 自动迁移表结构
-	if err := db.AutoMigrate(&model.ProxyUserApp{}); err != nil {
+	if err := db.AutoMigrate(&model.ProxyUserApp{}, &model.Operation{}, &model.RBACBinding{}); err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
@@ -203,13 +209,122 @@ func (d *Database) GetJosAppIDByID(id uint64) (uint64, error) {
 	return app.AppID, nil
 }
 
+var (
+	// ErrUserNotFound 用户不存在
+	ErrUserNotFound = errors.New("user not found")
+	// ErrAppNotFound 应用不存在
+	ErrAppNotFound = errors.New("app not found")
+)
+
 func (d *Database) GetUserByID(userID uint64) (model.XjrUser, error) {
 	var user model.XjrUser
 	if err := d.JosDb.First(&user, userID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return model.XjrUser{}, fmt.Errorf("user with ID %d not found", userID)
+			return model.XjrUser{}, fmt.Errorf("%w: ID %d", ErrUserNotFound, userID)
 		}
 		return model.XjrUser{}, fmt.Errorf("failed to get user by ID %d: %w", userID, err)
 	}
 	return user, nil
 }
+
+// GetJosUserApps 获取用户关联的应用
+func (d *Database) GetJosUserApps(userID uint64) ([]model.JosUserApp, error) {
+	var apps []model.JosUserApp
+	if err := d.JosDb.Where("user_id = ?", userID).Find(&apps).Error; err != nil {
+		return nil, fmt.Errorf("failed to get apps of user %d: %w", userID, err)
+	}
+	return apps, nil
+}
+
+// GetJosAppByAppID 根据应用ID获取应用
+func (d *Database) GetJosAppByAppID(appID uint64) (*model.JosApp, error) {
+	var app model.JosApp
+	if err := d.JosDb.Where("app_id = ?", appID).First(&app).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: app ID %d", ErrAppNotFound, appID)
+		}
+		return nil, fmt.Errorf("failed to query app %d: %w", appID, err)
+	}
+	return &app, nil
+}
+
+// GetUserWorkspaceIDs 通过用户关联的应用获取用户所属的工作空间
+func (d *Database) GetUserWorkspaceIDs(userID uint64) ([]uint64, error) {
+	var ids []uint64
+	userApps := d.JosDb.Model(&model.JosUserApp{}).Select("app_id").Where("user_id = ?", userID)
+	if err := d.JosDb.Model(&model.JosApp{}).Distinct("workspace_id").
+		Where("app_id IN (?)", userApps).Pluck("workspace_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get workspaces of user %d: %w", userID, err)
+	}
+	return ids, nil
+}
+
+// ListRBACBindings 获取全部角色绑定
+func (d *Database) ListRBACBindings() ([]model.RBACBinding, error) {
+	var bindings []model.RBACBinding
+	if err := d.SqliteDb.Order("id").Find(&bindings).Error; err != nil {
+		return nil, fmt.Errorf("failed to list rbac bindings: %w", err)
+	}
+	return bindings, nil
+}
+
+// ErrOperationNotFound 异步操作不存在
+var ErrOperationNotFound = errors.New("operation not found")
+
+// OperationFilter 异步操作列表的过滤条件，空字段表示不过滤
+type OperationFilter struct {
+	Namespace   string
+	ReleaseName string
+	Type        string
+	Statuses    []string
+}
+
+func (d *Database) CreateOperation(op *model.Operation) error {
+	return d.SqliteDb.Create(op).Error
+}
+
+func (d *Database) SaveOperation(op *model.Operation) error {
+	return d.SqliteDb.Save(op).Error
+}
+
+func (d *Database) GetOperation(id string) (*model.Operation, error) {
+	var op model.Operation
+	if err := d.SqliteDb.Where("id = ?", id).First(&op).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrOperationNotFound
+		}
+		return nil, fmt.Errorf("failed to get operation %s: %w", id, err)
+	}
+	return &op, nil
+}
+
+// ListOperations 按创建时间倒序分页查询，limit <= 0 时返回全部
+func (d *Database) ListOperations(filter OperationFilter, offset, limit int) ([]model.Operation, int64, error) {
+	query := d.SqliteDb.Model(&model.Operation{})
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
+	if filter.ReleaseName != "" {
+		query = query.Where("release_name = ?", filter.ReleaseName)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count operations: %w", err)
+	}
+	query = query.Order("create_date DESC").Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var ops []model.Operation
+	if err := query.Find(&ops).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list operations: %w", err)
+	}
+	return ops, total, nil
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Operation 异步操作记录（安装/升级/卸载/回滚），保存在 sqlite 中
+type Operation struct {
+	ID          string     `gorm:"column:id;primaryKey;type:varchar(36)" json:"id"`
+	Type        string     `gorm:"column:type;type:varchar(20);index" json:"type"`                // install/upgrade/uninstall/rollback
+	Namespace   string     `gorm:"column:namespace;type:varchar(63);index" json:"namespace"`      // 命名空间
+	ReleaseName string     `gorm:"column:release_name;type:varchar(53);index" json:"releaseName"` // Release 名称
+	Status      string     `gorm:"column:status;type:varchar(20);index" json:"status"`            // Pending/Running/Succeeded/Failed/Cancelled
+	Message     string     `gorm:"column:message;type:text" json:"message"`                       // 进度或错误信息
+	Request     string     `gorm:"column:request;type:text" json:"request"`                       // protojson 编码的请求（Any）
+	Result      string     `gorm:"column:result;type:text" json:"result"`                         // protojson 编码的响应（Any）
+	UserID      string     `gorm:"column:user_id;type:varchar(50)" json:"userId"`                 // 发起人
+	CreateDate  time.Time  `gorm:"column:create_date;autoCreateTime" json:"createDate"`           // 创建时间
+	StartDate   *time.Time `gorm:"column:start_date" json:"startDate"`                            // 开始执行时间
+	FinishDate  *time.Time `gorm:"column:finish_date" json:"finishDate"`                          // 结束时间
+	ModifyDate  time.Time  `gorm:"column:modify_date;autoUpdateTime" json:"modifyDate"`           // 修改时间（自动更新）
+}
+
+func (Operation) TableName() string {
+	return "helm_operation"
+}
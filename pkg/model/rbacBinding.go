@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// RBACBinding 角色绑定，RBAC_POLICY_SOURCE=db 时从 sqlite 加载，保存在 helm_rbac_binding 表中
+type RBACBinding struct {
+	ID          uint64    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	SubjectKind string    `gorm:"column:subject_kind;type:varchar(20);not null" json:"subjectKind"` // user/role/tenant/app_id/app_name
+	Subject     string    `gorm:"column:subject;type:varchar(100);not null" json:"subject"`         // 用户ID或账号、token 中的角色、租户ID、应用ID或名称
+	Role        string    `gorm:"column:role;type:varchar(50);not null" json:"role"`                // 绑定的角色
+	Namespaces  string    `gorm:"column:namespaces;type:text" json:"namespaces"`                    // 逗号分隔的命名空间，支持通配符，为空时不额外限制
+	CreateDate  time.Time `gorm:"column:create_date;autoCreateTime" json:"createDate"`              // 创建时间
+	ModifyDate  time.Time `gorm:"column:modify_date;autoUpdateTime" json:"modifyDate"`              // 修改时间（自动更新）
+}
+
+func (RBACBinding) TableName() string {
+	return "helm_rbac_binding"
+}
@@ -0,0 +1,92 @@
+package operation
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"jos-deployment/pkg/db"
+	"jos-deployment/pkg/model"
+)
+
+// MemoryStore 在 sqlite 不可用时使用的内存存储，进程重启后记录丢失
+type MemoryStore struct {
+	mu  sync.RWMutex
+	ops map[string]model.Operation
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{ops: map[string]model.Operation{}}
+}
+
+func (s *MemoryStore) CreateOperation(op *model.Operation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if op.CreateDate.IsZero() {
+		op.CreateDate = time.Now()
+	}
+	op.ModifyDate = op.CreateDate
+	s.ops[op.ID] = *op
+	return nil
+}
+
+func (s *MemoryStore) SaveOperation(op *model.Operation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op.ModifyDate = time.Now()
+	s.ops[op.ID] = *op
+	return nil
+}
+
+func (s *MemoryStore) GetOperation(id string) (*model.Operation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	op, ok := s.ops[id]
+	if !ok {
+		return nil, db.ErrOperationNotFound
+	}
+	return &op, nil
+}
+
+func (s *MemoryStore) ListOperations(filter db.OperationFilter, offset, limit int) ([]model.Operation, int64, error) {
+	s.mu.RLock()
+	var ops []model.Operation
+	for _, op := range s.ops {
+		if matchFilter(&op, filter) {
+			ops = append(ops, op)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(ops, func(i, j int) bool { return ops[i].CreateDate.After(ops[j].CreateDate) })
+	total := int64(len(ops))
+	if offset > len(ops) {
+		offset = len(ops)
+	}
+	ops = ops[offset:]
+	if limit > 0 && limit < len(ops) {
+		ops = ops[:limit]
+	}
+	return ops, total, nil
+}
+
+func matchFilter(op *model.Operation, filter db.OperationFilter) bool {
+	if filter.Namespace != "" && op.Namespace != filter.Namespace {
+		return false
+	}
+	if filter.ReleaseName != "" && op.ReleaseName != filter.ReleaseName {
+		return false
+	}
+	if filter.Type != "" && op.Type != filter.Type {
+		return false
+	}
+	if len(filter.Statuses) == 0 {
+		return true
+	}
+	for _, status := range filter.Statuses {
+		if op.Status == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,347 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"jos-deployment/pkg/db"
+	"jos-deployment/pkg/logger"
+	"jos-deployment/pkg/model"
+)
+
+// 操作类型
+const (
+	TypeInstall   = "install"
+	TypeUpgrade   = "upgrade"
+	TypeUninstall = "uninstall"
+	TypeRollback  = "rollback"
+)
+
+// 操作状态
+const (
+	StatusPending   = "Pending"
+	StatusRunning   = "Running"
+	StatusSucceeded = "Succeeded"
+	StatusFailed    = "Failed"
+	StatusCancelled = "Cancelled"
+)
+
+var (
+	ErrQueueFull   = errors.New("operation queue is full")
+	ErrFinished    = errors.New("operation already finished")
+	ErrUnknownType = errors.New("unknown operation type")
+)
+
+// Func 实际执行的操作，返回的 proto 消息作为操作结果保存
+type Func func(ctx context.Context) (proto.Message, error)
+
+// Builder 根据请求构造 Func，服务重启后用持久化的请求重新构造排队中的操作
+type Builder func(req proto.Message) (Func, error)
+
+// Store 操作记录的持久化接口，*db.Database 实现了该接口
+type Store interface {
+	CreateOperation(op *model.Operation) error
+	SaveOperation(op *model.Operation) error
+	GetOperation(id string) (*model.Operation, error)
+	ListOperations(filter db.OperationFilter, offset, limit int) ([]model.Operation, int64, error)
+}
+
+// Manager 以有界的 worker 池执行操作，并把进度写入 Store
+type Manager struct {
+	store    Store
+	queue    chan string
+	builders map[string]Builder
+
+	mu      sync.Mutex
+	pending map[string]Func
+	cancels map[string]context.CancelFunc
+}
+
+// NewManager 创建 Manager 并启动 workers 个执行协程，排队中的操作最多 queueSize 个
+func NewManager(store Store, workers, queueSize int) *Manager {
+	m := &Manager{
+		store:    store,
+		queue:    make(chan string, queueSize),
+		builders: map[string]Builder{},
+		pending:  map[string]Func{},
+		cancels:  map[string]context.CancelFunc{},
+	}
+	for i := 0; i < workers; i++ {
+		go m.worker()
+	}
+	return m
+}
+
+// Register 注册某类操作的 Builder，需在 Submit/Recover 之前调用
+func (m *Manager) Register(opType string, builder Builder) {
+	m.builders[opType] = builder
+}
+
+// Submit 记录操作并放入队列，立即返回处于 Pending 状态的操作
+func (m *Manager) Submit(opType, namespace, releaseName, userID string, req proto.Message) (*model.Operation, error) {
+	builder, ok := m.builders[opType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, opType)
+	}
+	fn, err := builder(req)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodeMessage(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	op := &model.Operation{
+		ID:          uuid.NewString(),
+		Type:        opType,
+		Namespace:   namespace,
+		ReleaseName: releaseName,
+		Status:      StatusPending,
+		Request:     encoded,
+		UserID:      userID,
+	}
+	if err := m.store.CreateOperation(op); err != nil {
+		return nil, err
+	}
+	if err := m.enqueue(op, fn); err != nil {
+		return op, err
+	}
+	return op, nil
+}
+
+func (m *Manager) enqueue(op *model.Operation, fn Func) error {
+	m.mu.Lock()
+	m.pending[op.ID] = fn
+	m.mu.Unlock()
+
+	select {
+	case m.queue <- op.ID:
+		return nil
+	default:
+		m.mu.Lock()
+		delete(m.pending, op.ID)
+		m.mu.Unlock()
+		m.finish(op, StatusFailed, ErrQueueFull.Error(), nil)
+		return ErrQueueFull
+	}
+}
+
+// Get 查询操作
+func (m *Manager) Get(id string) (*model.Operation, error) {
+	return m.store.GetOperation(id)
+}
+
+// List 分页查询操作
+func (m *Manager) List(filter db.OperationFilter, offset, limit int) ([]model.Operation, int64, error) {
+	return m.store.ListOperations(filter, offset, limit)
+}
+
+// Cancel 取消操作：排队中的直接标记为 Cancelled，执行中的取消其 context，
+// 是否能中断取决于具体操作是否响应 context
+func (m *Manager) Cancel(id string) (*model.Operation, error) {
+	op, err := m.store.GetOperation(id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	_, queued := m.pending[id]
+	delete(m.pending, id)
+	cancel, running := m.cancels[id]
+	m.mu.Unlock()
+
+	switch {
+	case queued:
+		m.finish(op, StatusCancelled, "cancelled before start", nil)
+	case running:
+		// 最终状态由执行协程写入，这里不落库避免覆盖
+		cancel()
+		op.Message = "cancellation requested"
+	default:
+		return op, ErrFinished
+	}
+	return op, nil
+}
+
+// Recover 在服务启动时调用：上次进程退出时执行中的操作标记为失败，排队中的操作重新入队
+func (m *Manager) Recover() error {
+	ops, _, err := m.store.ListOperations(db.OperationFilter{
+		Statuses: []string{StatusPending, StatusRunning},
+	}, 0, 0)
+	if err != nil {
+		return err
+	}
+	// 按创建时间顺序重新入队
+	sort.Slice(ops, func(i, j int) bool { return ops[i].CreateDate.Before(ops[j].CreateDate) })
+	for i := range ops {
+		op := &ops[i]
+		if op.Status == StatusRunning {
+			m.finish(op, StatusFailed, "interrupted by service restart", nil)
+			continue
+		}
+		fn, err := m.rebuild(op)
+		if err != nil {
+			m.finish(op, StatusFailed, fmt.Sprintf("failed to resume operation: %v", err), nil)
+			continue
+		}
+		if err := m.enqueue(op, fn); err != nil {
+			logger.L().Error("Failed to resume operation", zap.String("id", op.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *Manager) rebuild(op *model.Operation) (Func, error) {
+	builder, ok := m.builders[op.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, op.Type)
+	}
+	req, err := DecodeMessage(op.Request)
+	if err != nil {
+		return nil, err
+	}
+	return builder(req)
+}
+
+func (m *Manager) worker() {
+	for id := range m.queue {
+		m.mu.Lock()
+		fn, ok := m.pending[id]
+		delete(m.pending, id)
+		ctx, cancel := context.WithCancel(context.Background())
+		if ok {
+			m.cancels[id] = cancel
+		}
+		m.mu.Unlock()
+		if !ok {
+			// 已被取消
+			cancel()
+			continue
+		}
+		m.run(ctx, id, fn)
+		cancel()
+		m.mu.Lock()
+		delete(m.cancels, id)
+		m.mu.Unlock()
+	}
+}
+
+func (m *Manager) run(ctx context.Context, id string, fn Func) {
+	op, err := m.store.GetOperation(id)
+	if err != nil {
+		logger.L().Error("Failed to load operation", zap.String("id", id), zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	op.Status = StatusRunning
+	op.StartDate = &now
+	op.Message = ""
+	if err := m.store.SaveOperation(op); err != nil {
+		logger.L().Error("Failed to update operation", zap.String("id", id), zap.Error(err))
+	}
+	logger.L().Info("Operation started", zap.String("id", id), zap.String("type", op.Type),
+		zap.String("namespace", op.Namespace), zap.String("release", op.ReleaseName))
+
+	ctx = context.WithValue(ctx, reporterKey{}, func(message string) {
+		op.Message = message
+		if err := m.store.SaveOperation(op); err != nil {
+			logger.L().Error("Failed to update operation", zap.String("id", id), zap.Error(err))
+		}
+	})
+	result, err := safeRun(ctx, fn)
+	switch {
+	case err == nil:
+		m.finish(op, StatusSucceeded, "", result)
+	case errors.Is(err, context.Canceled) || ctx.Err() != nil:
+		m.finish(op, StatusCancelled, err.Error(), result)
+	default:
+		m.finish(op, StatusFailed, err.Error(), result)
+	}
+}
+
+type reporterKey struct{}
+
+// Report 在操作执行过程中记录进度信息，ctx 不是由 Manager 创建时忽略
+func Report(ctx context.Context, message string) {
+	if report, ok := ctx.Value(reporterKey{}).(func(string)); ok {
+		report(message)
+	}
+}
+
+// safeRun 防止单个操作 panic 导致 worker 退出
+func safeRun(ctx context.Context, fn Func) (result proto.Message, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("operation panicked: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+func (m *Manager) finish(op *model.Operation, status, message string, result proto.Message) {
+	now := time.Now()
+	op.Status = status
+	op.Message = message
+	op.FinishDate = &now
+	if result != nil {
+		encoded, err := encodeMessage(result)
+		if err != nil {
+			logger.L().Error("Failed to encode operation result", zap.String("id", op.ID), zap.Error(err))
+		} else {
+			op.Result = encoded
+		}
+	}
+	if err := m.store.SaveOperation(op); err != nil {
+		logger.L().Error("Failed to update operation", zap.String("id", op.ID), zap.Error(err))
+	}
+	logger.L().Info("Operation finished", zap.String("id", op.ID), zap.String("status", status), zap.String("message", message))
+}
+
+// encodeMessage 把消息包装为 Any 后以 protojson 编码，便于落库后还原具体类型
+func encodeMessage(msg proto.Message) (string, error) {
+	anyMsg, err := anypb.New(msg)
+	if err != nil {
+		return "", err
+	}
+	data, err := protojson.Marshal(anyMsg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DecodeAny 还原 encodeMessage 编码的内容，空字符串返回 nil
+func DecodeAny(data string) (*anypb.Any, error) {
+	if data == "" {
+		return nil, nil
+	}
+	anyMsg := &anypb.Any{}
+	if err := protojson.Unmarshal([]byte(data), anyMsg); err != nil {
+		return nil, err
+	}
+	return anyMsg, nil
+}
+
+// DecodeMessage 还原 encodeMessage 编码的具体消息
+func DecodeMessage(data string) (proto.Message, error) {
+	anyMsg, err := DecodeAny(data)
+	if err != nil {
+		return nil, err
+	}
+	if anyMsg == nil {
+		return nil, errors.New("empty message")
+	}
+	return anyMsg.UnmarshalNew()
+}
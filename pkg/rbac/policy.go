@@ -0,0 +1,229 @@
+package rbac
+
+import (
+	"fmt"
+	"path"
+)
+
+// Policy 角色定义及角色绑定。方法和命名空间均支持 path.Match 通配符，单独的 * 匹配全部
+type Policy struct {
+	Roles    []Role    `json:"roles,omitempty"`
+	Bindings []Binding `json:"bindings,omitempty"`
+}
+
+// Role 一组可调用的方法及其生效的命名空间
+type Role struct {
+	Name  string `json:"name"`
+	Rules []Rule `json:"rules"`
+}
+
+// Rule Methods 为 gRPC 完整方法名，如 /helm.v1alpha1.HelmManagerService/InstallChart；
+// Namespaces 为空时表示全部命名空间
+type Rule struct {
+	Methods    []string `json:"methods"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Binding 把角色授予满足任一条件的调用方：用户ID或账号、token 中的角色、租户、关联应用的ID或名称。
+// Namespaces 非空时进一步把角色限制在这些命名空间内
+type Binding struct {
+	Role       string   `json:"role"`
+	Users      []string `json:"users,omitempty"`
+	Roles      []string `json:"roles,omitempty"`
+	Tenants    []string `json:"tenants,omitempty"`
+	AppIDs     []string `json:"app_ids,omitempty"`
+	AppNames   []string `json:"app_names,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Decision 授权结果
+type Decision struct {
+	Allowed bool
+	Reason  string
+	// Role 授权的角色，拒绝时为空
+	Role string
+}
+
+// viewerMethods 只读接口
+var viewerMethods = []string{
+	"/*/List*",
+	"/*/Get*",
+	"/*/Check*",
+	"/*/WatchInstallStatus",
+	"/*/DiffRelease",
+	"/*/PodsMetrics",
+}
+
+// developerMethods 在只读接口之外管理应用和工作负载的接口，不包括仓库、证书和节点管理
+var developerMethods = append(append([]string(nil), viewerMethods...),
+	"/helm.v1alpha1.HelmManagerService/InstallChart",
+	"/helm.v1alpha1.HelmManagerService/UpgradeChart",
+	"/helm.v1alpha1.HelmManagerService/RollbackChart",
+	"/helm.v1alpha1.HelmManagerService/UninstallChart",
+	"/helm.v1alpha1.HelmManagerService/CreateChartApplication",
+	"/helm.v1alpha1.HelmManagerService/CancelOperation",
+	"/helm.v1alpha1.HelmManagerService/UploadChart",
+	"/helm.v1alpha1.HelmManagerService/EnsureWorkspaceNamespace",
+	"/pod.v1alpha1.PodManagerService/DeletePod",
+	"/pod.v1alpha1.PodManagerService/ExecPodTerminal",
+	"/pod.v1alpha1.PodManagerService/Configure*",
+	"/pod.v1alpha1.PodManagerService/Create*",
+	"/apisix.v1alpha1.APISIXGatewayService/*Route",
+	"/apisix.v1alpha1.APISIXGatewayService/CreateUpstream",
+	"/apisix.v1alpha1.APISIXGatewayService/*Componment",
+	"/apisix.v1alpha1.APISIXGatewayService/JumpAndLogin",
+)
+
+// DefaultRoles 内置角色，策略中同名的角色会覆盖内置定义
+func DefaultRoles() []Role {
+	return []Role{
+		{Name: "admin", Rules: []Rule{{Methods: []string{"*"}}}},
+		{Name: "developer", Rules: []Rule{{Methods: developerMethods}}},
+		{Name: "viewer", Rules: []Rule{{Methods: viewerMethods}}},
+	}
+}
+
+// withDefaultRoles 合并内置角色，策略中的定义优先
+func (p *Policy) withDefaultRoles() *Policy {
+	merged := &Policy{Bindings: p.Bindings}
+	defined := map[string]bool{}
+	for _, role := range p.Roles {
+		defined[role.Name] = true
+		merged.Roles = append(merged.Roles, role)
+	}
+	for _, role := range DefaultRoles() {
+		if !defined[role.Name] {
+			merged.Roles = append(merged.Roles, role)
+		}
+	}
+	return merged
+}
+
+func (p *Policy) validate() error {
+	roles := map[string]bool{}
+	for _, role := range p.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role without name")
+		}
+		for _, rule := range role.Rules {
+			if err := validatePatterns(rule.Methods); err != nil {
+				return fmt.Errorf("role %s: %w", role.Name, err)
+			}
+			if err := validatePatterns(rule.Namespaces); err != nil {
+				return fmt.Errorf("role %s: %w", role.Name, err)
+			}
+		}
+		roles[role.Name] = true
+	}
+	for i, b := range p.Bindings {
+		if !roles[b.Role] {
+			return fmt.Errorf("binding %d refers to unknown role %q", i, b.Role)
+		}
+		if err := validatePatterns(b.Namespaces); err != nil {
+			return fmt.Errorf("binding %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validatePatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q", p)
+		}
+	}
+	return nil
+}
+
+// authorize 依次检查与调用方匹配的绑定，命中第一条允许的规则即返回
+func (p *Policy) authorize(sub *Subject, method, namespace string) Decision {
+	roles := make(map[string]*Role, len(p.Roles))
+	for i := range p.Roles {
+		roles[p.Roles[i].Name] = &p.Roles[i]
+	}
+	for _, b := range p.Bindings {
+		via, ok := b.matches(sub)
+		if !ok {
+			continue
+		}
+		role := roles[b.Role]
+		if role == nil {
+			continue
+		}
+		if len(b.Namespaces) > 0 && !namespaceAllowed(b.Namespaces, namespace) {
+			continue
+		}
+		for _, rule := range role.Rules {
+			if matchAny(rule.Methods, method) && (len(rule.Namespaces) == 0 || namespaceAllowed(rule.Namespaces, namespace)) {
+				return Decision{
+					Allowed: true,
+					Role:    role.Name,
+					Reason:  fmt.Sprintf("granted by role %s bound to %s", role.Name, via),
+				}
+			}
+		}
+	}
+	if namespace == "" {
+		return Decision{Reason: fmt.Sprintf("no role grants %s cluster-wide", method)}
+	}
+	return Decision{Reason: fmt.Sprintf("no role grants %s in namespace %s", method, namespace)}
+}
+
+// matches 判断绑定是否适用于调用方，返回命中的条件用于说明授权依据
+func (b *Binding) matches(sub *Subject) (string, bool) {
+	for _, u := range b.Users {
+		if u == "*" || u == sub.UserID || (sub.UserName != "" && u == sub.UserName) {
+			return "user " + u, true
+		}
+	}
+	for _, r := range b.Roles {
+		if contains(sub.Roles, r) {
+			return "role " + r, true
+		}
+	}
+	for _, t := range b.Tenants {
+		if sub.Tenant != "" && t == sub.Tenant {
+			return "tenant " + t, true
+		}
+	}
+	for _, a := range b.AppIDs {
+		if contains(sub.AppIDs, a) {
+			return "app id " + a, true
+		}
+	}
+	for _, a := range b.AppNames {
+		if contains(sub.AppNames, a) {
+			return "app name " + a, true
+		}
+	}
+	return "", false
+}
+
+// namespaceAllowed 命名空间为空表示集群范围或全部命名空间，只有 * 能匹配
+func namespaceAllowed(patterns []string, namespace string) bool {
+	if namespace == "" {
+		return contains(patterns, "*")
+	}
+	return matchAny(patterns, namespace)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if p == "*" {
+			return true
+		}
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
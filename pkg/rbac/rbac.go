@@ -0,0 +1,222 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"sigs.k8s.io/yaml"
+
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/db"
+	"jos-deployment/pkg/logger"
+)
+
+const defaultReloadInterval = time.Minute
+
+// ErrUnavailable 用户中心数据库不可用，无法确认调用方是否被禁用
+var ErrUnavailable = errors.New("user center is unavailable")
+
+// Source 策略来源
+type Source interface {
+	Load() (*Policy, error)
+}
+
+// FileSource 从 YAML 或 JSON 文件加载策略
+type FileSource struct {
+	Path string
+}
+
+func (s FileSource) Load() (*Policy, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", s.Path, err)
+	}
+	return &policy, nil
+}
+
+// DBSource 从 sqlite 的 helm_rbac_binding 表加载角色绑定，角色使用内置定义
+type DBSource struct{}
+
+func (DBSource) Load() (*Policy, error) {
+	if db.DB.SqliteDb == nil {
+		return nil, errors.New("sqlite database is not initialized")
+	}
+	rows, err := db.DB.ListRBACBindings()
+	if err != nil {
+		return nil, err
+	}
+	policy := &Policy{}
+	for _, row := range rows {
+		b := Binding{Role: row.Role, Namespaces: splitList(row.Namespaces)}
+		switch row.SubjectKind {
+		case "user":
+			b.Users = []string{row.Subject}
+		case "role":
+			b.Roles = []string{row.Subject}
+		case "tenant":
+			b.Tenants = []string{row.Subject}
+		case "app_id":
+			b.AppIDs = []string{row.Subject}
+		case "app_name":
+			b.AppNames = []string{row.Subject}
+		default:
+			logger.L().Warn("Skipping rbac binding with unknown subject kind",
+				zap.Uint64("id", row.ID), zap.String("subject_kind", row.SubjectKind))
+			continue
+		}
+		policy.Bindings = append(policy.Bindings, b)
+	}
+	return policy, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Authorizer 按策略判定调用方能否在某个命名空间调用某个方法，策略定期从 Source 重新加载
+type Authorizer struct {
+	source Source
+	users  *userCache
+
+	mu     sync.RWMutex
+	policy *Policy
+}
+
+// New 加载策略并创建 Authorizer，策略无效时返回错误
+func New(source Source) (*Authorizer, error) {
+	a := &Authorizer{source: source, users: newUserCache()}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload 重新加载策略，失败时保留当前策略
+func (a *Authorizer) Reload() error {
+	policy, err := a.source.Load()
+	if err != nil {
+		return fmt.Errorf("load rbac policy: %w", err)
+	}
+	policy = policy.withDefaultRoles()
+	if err := policy.validate(); err != nil {
+		return fmt.Errorf("invalid rbac policy: %w", err)
+	}
+	a.mu.Lock()
+	a.policy = policy
+	a.mu.Unlock()
+	return nil
+}
+
+// watch 定期重新加载策略
+func (a *Authorizer) watch(interval time.Duration) {
+	for range time.Tick(interval) {
+		if err := a.Reload(); err != nil {
+			logger.L().Error("Failed to reload rbac policy", zap.Error(err))
+		}
+	}
+}
+
+// Authorize 判定调用方能否在 namespace 中调用 method，namespace 为空表示集群范围或全部命名空间。
+// 无法从用户中心确认调用方状态时返回 ErrUnavailable
+func (a *Authorizer) Authorize(id *auth.Identity, method, namespace string) (Decision, error) {
+	sub, err := a.users.subject(id)
+	if err != nil {
+		return Decision{}, err
+	}
+	if sub.Disabled {
+		return Decision{Reason: fmt.Sprintf("user %s is disabled", id.UserID)}, nil
+	}
+	a.mu.RLock()
+	policy := a.policy
+	a.mu.RUnlock()
+	return policy.authorize(sub, method, namespace), nil
+}
+
+var defaultAuthorizer *Authorizer
+
+// Default 返回 InitFromEnv 创建的 Authorizer，未启用 RBAC 时为 nil
+func Default() *Authorizer {
+	return defaultAuthorizer
+}
+
+// InitFromEnv 按环境变量启用 RBAC：RBAC_POLICY_FILE 指定策略文件，或 RBAC_POLICY_SOURCE=db
+// 从 sqlite 加载角色绑定；策略每隔 RBAC_RELOAD_INTERVAL（默认 1m）重新加载。
+// 两者都未配置时不启用，返回 nil
+func InitFromEnv() (*Authorizer, error) {
+	var source Source
+	switch {
+	case os.Getenv("RBAC_POLICY_FILE") != "":
+		source = FileSource{Path: os.Getenv("RBAC_POLICY_FILE")}
+	case os.Getenv("RBAC_POLICY_SOURCE") == "db":
+		source = DBSource{}
+	default:
+		logger.L().Warn("RBAC is disabled, every authenticated caller has full access")
+		return nil, nil
+	}
+	interval := defaultReloadInterval
+	if v := os.Getenv("RBAC_RELOAD_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid RBAC_RELOAD_INTERVAL %q", v)
+		}
+		interval = d
+	}
+	a, err := New(source)
+	if err != nil {
+		return nil, err
+	}
+	go a.watch(interval)
+	defaultAuthorizer = a
+	return a, nil
+}
+
+// ResolveMethod 把方法名解析为 gRPC 完整方法名。以 / 开头时原样返回，否则在已注册的服务中
+// 查找方法名为 name 或以 Service/Method 形式结尾的方法
+func ResolveMethod(name string) (string, error) {
+	if strings.HasPrefix(name, "/") {
+		return name, nil
+	}
+	if name == "" {
+		return "", errors.New("method is required")
+	}
+	var matches []string
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			svc := services.Get(i)
+			methods := svc.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				full := "/" + string(svc.FullName()) + "/" + string(methods.Get(j).Name())
+				if strings.HasSuffix(full, "/"+name) || strings.HasSuffix(full, "."+name) {
+					matches = append(matches, full)
+				}
+			}
+		}
+		return true
+	})
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unknown method %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("method %q is ambiguous: %s", name, strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,128 @@
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"jos-deployment/pkg/auth"
+	"jos-deployment/pkg/db"
+)
+
+type memberEntry struct {
+	workspaces map[uint64]bool
+	expires    time.Time
+}
+
+// IsAdmin 判断调用方是否拥有不受工作空间限制的角色
+func (m *Manager) IsAdmin(id *auth.Identity) bool {
+	for _, role := range m.cfg.AdminRoles {
+		if id.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// workspaces 调用方所属的工作空间：token 中 WorkspacesClaim 列出的工作空间，
+// 以及连接了用户中心数据库时通过 jos_user_app 关联的应用所在的工作空间。
+// 缓存只保存数据库中查到的部分，token 中的工作空间每次按当前 token 合并
+func (m *Manager) workspaces(id *auth.Identity) (map[uint64]bool, error) {
+	workspaces := map[uint64]bool{}
+	for _, ws := range claimIDs(id.Claims[m.cfg.WorkspacesClaim]) {
+		workspaces[ws] = true
+	}
+	userID, err := strconv.ParseUint(id.UserID, 10, 64)
+	if err != nil || db.DB.JosDb == nil {
+		return workspaces, nil
+	}
+
+	m.mu.Lock()
+	entry, ok := m.members[userID]
+	m.mu.Unlock()
+	if !ok || !time.Now().Before(entry.expires) {
+		ids, err := db.DB.GetUserWorkspaceIDs(userID)
+		if err != nil {
+			return nil, err
+		}
+		entry = memberEntry{workspaces: map[uint64]bool{}, expires: time.Now().Add(cacheTTL)}
+		for _, ws := range ids {
+			entry.workspaces[ws] = true
+		}
+		m.mu.Lock()
+		m.members[userID] = entry
+		m.mu.Unlock()
+	}
+	for ws := range entry.workspaces {
+		workspaces[ws] = true
+	}
+	return workspaces, nil
+}
+
+// claimIDs 读取数字数组、字符串数组或逗号分隔字符串形式的工作空间ID
+func claimIDs(value interface{}) []uint64 {
+	var items []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			items = append(items, strings.TrimSpace(s))
+		}
+	default:
+		items = []interface{}{v}
+	}
+	var ids []uint64
+	for _, item := range items {
+		switch v := item.(type) {
+		case float64:
+			if v > 0 {
+				ids = append(ids, uint64(v))
+			}
+		case string:
+			if id, err := strconv.ParseUint(v, 10, 64); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// CheckWorkspace 校验调用方属于该工作空间
+func (m *Manager) CheckWorkspace(id *auth.Identity, workspaceID uint64) error {
+	if m.IsAdmin(id) {
+		return nil
+	}
+	workspaces, err := m.workspaces(id)
+	if err != nil {
+		return fmt.Errorf("load workspaces of user %s: %w", id.UserID, err)
+	}
+	if !workspaces[workspaceID] {
+		return fmt.Errorf("%w: user %s is not a member of workspace %d", ErrForbidden, id.UserID, workspaceID)
+	}
+	return nil
+}
+
+// CheckNamespace 校验调用方能否访问命名空间：非管理员只能访问所属工作空间的托管命名空间，
+// namespace 为空（集群范围或全部命名空间）时只允许管理员
+func (m *Manager) CheckNamespace(ctx context.Context, id *auth.Identity, namespace string) error {
+	if m.IsAdmin(id) {
+		return nil
+	}
+	if namespace == "" {
+		return fmt.Errorf("%w: access to all namespaces requires an admin role", ErrForbidden)
+	}
+	workspace, managed, err := m.namespaceWorkspace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		return fmt.Errorf("%w: namespace %s does not belong to any workspace", ErrForbidden, namespace)
+	}
+	if err := m.CheckWorkspace(id, workspace); err != nil {
+		return fmt.Errorf("%w (namespace %s)", err, namespace)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"jos-deployment/pkg/logger"
+)
+
+const (
+	quotaName         = "jos-quota"
+	limitRangeName    = "jos-limits"
+	networkPolicyName = "jos-default-ingress"
+)
+
+type namespaceEntry struct {
+	workspace uint64
+	managed   bool
+	expires   time.Time
+}
+
+// EnsureNamespace 返回 Scope 对应的托管命名空间，不存在时创建并配置 ResourceQuota、
+// LimitRange 和默认 NetworkPolicy。命名空间已存在时补齐缺失的对象，不覆盖已有配置
+func (m *Manager) EnsureNamespace(ctx context.Context, scope Scope) (string, bool, error) {
+	name := m.cfg.Namespace(scope)
+	labels := map[string]string{
+		LabelManagedBy: managedByValue,
+		LabelWorkspace: strconv.FormatUint(scope.WorkspaceID, 10),
+		LabelProject:   strconv.FormatUint(scope.ProjectID, 10),
+		LabelEnv:       strconv.FormatUint(scope.EnvID, 10),
+	}
+
+	created := false
+	ns, err := m.client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		if _, err := m.client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return "", false, fmt.Errorf("create namespace %s: %w", name, err)
+		}
+		created = true
+	case err != nil:
+		return "", false, fmt.Errorf("get namespace %s: %w", name, err)
+	default:
+		for key, value := range labels {
+			if ns.Labels[key] != value {
+				return "", false, fmt.Errorf("%w: %s", ErrNamespaceConflict, name)
+			}
+		}
+	}
+
+	if err := m.ensureObjects(ctx, name, labels); err != nil {
+		return "", false, err
+	}
+	m.mu.Lock()
+	delete(m.namespaces, name)
+	m.mu.Unlock()
+	if created {
+		logger.L().Info("Workspace namespace created", zap.String("namespace", name),
+			zap.Uint64("workspace_id", scope.WorkspaceID), zap.Uint64("project_id", scope.ProjectID), zap.Uint64("env_id", scope.EnvID))
+	}
+	return name, created, nil
+}
+
+// ensureObjects 创建命名空间内的默认对象，已存在的对象保持不变
+func (m *Manager) ensureObjects(ctx context.Context, namespace string, labels map[string]string) error {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{LabelManagedBy: managedByValue}}
+	}
+
+	if len(m.cfg.Quota) > 0 {
+		quota := &corev1.ResourceQuota{
+			ObjectMeta: meta(quotaName),
+			Spec:       corev1.ResourceQuotaSpec{Hard: m.cfg.Quota},
+		}
+		_, err := m.client.CoreV1().ResourceQuotas(namespace).Create(ctx, quota, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create resource quota in %s: %w", namespace, err)
+		}
+	}
+
+	if len(m.cfg.LimitDefault) > 0 || len(m.cfg.LimitDefaultRequest) > 0 {
+		limits := &corev1.LimitRange{
+			ObjectMeta: meta(limitRangeName),
+			Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				Default:        m.cfg.LimitDefault,
+				DefaultRequest: m.cfg.LimitDefaultRequest,
+			}}},
+		}
+		_, err := m.client.CoreV1().LimitRanges(namespace).Create(ctx, limits, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create limit range in %s: %w", namespace, err)
+		}
+	}
+
+	// 默认只允许同一命名空间内的访问，以及 IngressNamespaces 中网关等组件的访问；出站不限制
+	peers := []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}
+	if len(m.cfg.IngressNamespaces) > 0 {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   m.cfg.IngressNamespaces,
+			}},
+		}})
+	}
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: meta(networkPolicyName),
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{{From: peers}},
+		},
+	}
+	_, err := m.client.NetworkingV1().NetworkPolicies(namespace).Create(ctx, policy, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("create network policy in %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// namespaceWorkspace 返回命名空间所属的工作空间，managed 为 false 表示不是托管命名空间或不存在
+func (m *Manager) namespaceWorkspace(ctx context.Context, name string) (uint64, bool, error) {
+	m.mu.Lock()
+	entry, ok := m.namespaces[name]
+	m.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.workspace, entry.managed, nil
+	}
+
+	entry = namespaceEntry{expires: time.Now().Add(cacheTTL)}
+	ns, err := m.client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return 0, false, fmt.Errorf("get namespace %s: %w", name, err)
+	case ns.Labels[LabelManagedBy] == managedByValue:
+		if id, err := strconv.ParseUint(ns.Labels[LabelWorkspace], 10, 64); err == nil {
+			entry.workspace, entry.managed = id, true
+		}
+	}
+
+	m.mu.Lock()
+	m.namespaces[name] = entry
+	m.mu.Unlock()
+	return entry.workspace, entry.managed, nil
+}
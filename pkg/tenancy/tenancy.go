@@ -0,0 +1,195 @@
+package tenancy
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"jos-deployment/pkg/logger"
+)
+
+// 托管命名空间上的标签，用于识别命名空间所属的工作空间、项目和环境
+const (
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+	LabelWorkspace = "jos.io/workspace-id"
+	LabelProject   = "jos.io/project-id"
+	LabelEnv       = "jos.io/env-id"
+
+	managedByValue = "jos-deployment"
+	cacheTTL       = 30 * time.Second
+)
+
+var (
+	// ErrForbidden 调用方不属于命名空间所在的工作空间
+	ErrForbidden = errors.New("namespace access denied")
+	// ErrNamespaceConflict 同名命名空间已存在但不属于该工作空间
+	ErrNamespaceConflict = errors.New("namespace exists and is not managed for this workspace")
+)
+
+// Scope 工作空间/项目/环境，对应一个托管命名空间
+type Scope struct {
+	WorkspaceID uint64
+	ProjectID   uint64
+	EnvID       uint64
+}
+
+// Config 托管命名空间的默认资源配置及访问控制配置
+type Config struct {
+	// NamespacePrefix 命名空间名称前缀，名称为 <prefix>-w<workspace>-p<project>[-e<env>]
+	NamespacePrefix string
+	// Quota 为空时不创建 ResourceQuota
+	Quota corev1.ResourceList
+	// LimitDefault、LimitDefaultRequest 都为空时不创建 LimitRange
+	LimitDefault        corev1.ResourceList
+	LimitDefaultRequest corev1.ResourceList
+	// IngressNamespaces 默认 NetworkPolicy 之外允许访问的命名空间，如网关所在的命名空间
+	IngressNamespaces []string
+	// AdminRoles 拥有这些角色的调用方不受工作空间限制
+	AdminRoles []string
+	// WorkspacesClaim token 中列出调用方所属工作空间ID的 claim
+	WorkspacesClaim string
+}
+
+// Namespace 返回 Scope 对应的托管命名空间名称
+func (c *Config) Namespace(s Scope) string {
+	name := fmt.Sprintf("%s-w%d-p%d", c.NamespacePrefix, s.WorkspaceID, s.ProjectID)
+	if s.EnvID != 0 {
+		name += fmt.Sprintf("-e%d", s.EnvID)
+	}
+	return name
+}
+
+// ConfigFromEnv 从环境变量读取配置：
+//   - TENANT_NAMESPACE_PREFIX: 默认 jos
+//   - TENANT_RESOURCE_QUOTA: 如 requests.cpu=8,limits.memory=32Gi,pods=100，设为空字符串时不创建
+//   - TENANT_LIMIT_DEFAULT / TENANT_LIMIT_DEFAULT_REQUEST: 容器默认 limits/requests，如 cpu=500m,memory=512Mi
+//   - TENANT_INGRESS_NAMESPACES: 逗号分隔，允许访问托管命名空间的其他命名空间
+//   - TENANCY_ADMIN_ROLES: 逗号分隔，默认 admin
+//   - TENANCY_WORKSPACES_CLAIM: 默认 workspaces
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		NamespacePrefix:   envOrDefault("TENANT_NAMESPACE_PREFIX", "jos"),
+		IngressNamespaces: splitList(os.Getenv("TENANT_INGRESS_NAMESPACES")),
+		AdminRoles:        splitList(envOrDefault("TENANCY_ADMIN_ROLES", "admin")),
+		WorkspacesClaim:   envOrDefault("TENANCY_WORKSPACES_CLAIM", "workspaces"),
+	}
+	var err error
+	if cfg.Quota, err = resourceListFromEnv("TENANT_RESOURCE_QUOTA",
+		"requests.cpu=8,requests.memory=16Gi,limits.cpu=16,limits.memory=32Gi,pods=100"); err != nil {
+		return cfg, err
+	}
+	if cfg.LimitDefault, err = resourceListFromEnv("TENANT_LIMIT_DEFAULT", "cpu=500m,memory=512Mi"); err != nil {
+		return cfg, err
+	}
+	if cfg.LimitDefaultRequest, err = resourceListFromEnv("TENANT_LIMIT_DEFAULT_REQUEST", "cpu=100m,memory=128Mi"); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// resourceListFromEnv 解析 name=quantity 列表，环境变量未设置时使用 def
+func resourceListFromEnv(key, def string) (corev1.ResourceList, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		value = def
+	}
+	list := corev1.ResourceList{}
+	for _, item := range splitList(value) {
+		name, quantity, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s entry %q", key, item)
+		}
+		q, err := resource.ParseQuantity(strings.TrimSpace(quantity))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", key, item, err)
+		}
+		list[corev1.ResourceName(strings.TrimSpace(name))] = q
+	}
+	return list, nil
+}
+
+// Manager 创建托管命名空间，并校验调用方能否访问某个命名空间
+type Manager struct {
+	client kubernetes.Interface
+	cfg    Config
+
+	mu         sync.Mutex
+	namespaces map[string]namespaceEntry
+	// members 按用户ID缓存用户中心数据库中查到的工作空间
+	members map[uint64]memberEntry
+}
+
+func NewManager(client kubernetes.Interface, cfg Config) *Manager {
+	return &Manager{
+		client:     client,
+		cfg:        cfg,
+		namespaces: map[string]namespaceEntry{},
+		members:    map[uint64]memberEntry{},
+	}
+}
+
+// Config 返回 Manager 使用的配置
+func (m *Manager) Config() Config {
+	return m.cfg
+}
+
+var defaultManager *Manager
+
+// Default 返回 InitFromEnv 创建的 Manager，未启用工作空间隔离时为 nil
+func Default() *Manager {
+	return defaultManager
+}
+
+// InitFromEnv TENANCY_ENABLED=true 时启用工作空间隔离，使用集群内配置（或本地 kubeconfig）
+// 连接集群。未启用时返回 nil
+func InitFromEnv() (*Manager, error) {
+	if os.Getenv("TENANCY_ENABLED") != "true" {
+		return nil, nil
+	}
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
+		}
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	defaultManager = NewManager(client, cfg)
+	logger.L().Info("Workspace namespace isolation enabled")
+	return defaultManager, nil
+}
@@ -0,0 +1,137 @@
+package terminal
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "jos-deployment/api/v1alpha1/pb_pod"
+	"jos-deployment/pkg/logger"
+)
+
+// DefaultIdleTimeout 终端在该时间内没有任何输入时自动断开
+const DefaultIdleTimeout = 15 * time.Minute
+
+// Stream 终端消息的传输层，gRPC 双向流和 WebSocket 都实现该接口
+type Stream interface {
+	Send(*pb.TerminalMessage) error
+	Recv() (*pb.TerminalMessage, error)
+}
+
+// Serve 第一个消息必须是 session_info，之后的消息为 stdin 数据或窗口大小调整，
+// 容器输出以 data 消息返回，会话结束时发送 exit 消息。idleTimeout 为 0 时使用默认值
+func (t *Terminal) Serve(ctx context.Context, stream Stream, idleTimeout time.Duration) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	info := first.GetSessionInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must carry session_info")
+	}
+	if info.GetNamespace() == "" || info.GetPodName() == "" {
+		return status.Errorf(codes.InvalidArgument, "namespace and pod_name are required")
+	}
+	logger.L().Info("ExecPodTerminal session started", zap.String("session", info.String()))
+
+	execCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultIdleTimeout
+	}
+	var idleExpired atomic.Bool
+	idle := time.AfterFunc(idleTimeout, func() {
+		idleExpired.Store(true)
+		cancel()
+	})
+	defer idle.Stop()
+
+	out := &streamWriter{stream: stream}
+	sizes := NewSizeQueue()
+	defer sizes.Close()
+	stdinReader, stdinWriter := io.Pipe()
+	defer stdinReader.Close()
+
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				// 客户端关闭发送方向时结束 stdin，其他错误直接终止会话
+				if err == io.EOF {
+					stdinWriter.Close()
+				} else {
+					stdinWriter.CloseWithError(err)
+					cancel()
+				}
+				return
+			}
+			idle.Reset(idleTimeout)
+			switch payload := msg.GetPayload().(type) {
+			case *pb.TerminalMessage_Data:
+				if _, err := stdinWriter.Write(payload.Data); err != nil {
+					return
+				}
+			case *pb.TerminalMessage_Resize:
+				sizes.Push(uint16(payload.Resize.GetWidth()), uint16(payload.Resize.GetHeight()))
+			}
+		}
+	}()
+
+	code, err := t.Exec(execCtx, Options{
+		Namespace: info.GetNamespace(),
+		PodName:   info.GetPodName(),
+		Container: info.GetContainer(),
+		Command:   info.GetCommand(),
+		TTY:       true,
+	}, stdinReader, out, nil, sizes)
+
+	exit := &pb.TerminalExit{ExitCode: int32(code)}
+	switch {
+	case idleExpired.Load():
+		exit.ExitCode = -1
+		exit.Reason = "idle timeout"
+	case ctx.Err() != nil:
+		// 客户端已断开，无需再发送结束消息
+		return nil
+	case err != nil:
+		logger.L().Error("ExecPodTerminal failed", zap.String("pod", info.GetPodName()), zap.Error(err))
+		return status.Errorf(codes.Internal, "exec into pod %s/%s failed: %v", info.GetNamespace(), info.GetPodName(), err)
+	}
+
+	logger.L().Info("ExecPodTerminal session finished",
+		zap.String("pod", info.GetPodName()),
+		zap.Int32("exit_code", exit.ExitCode),
+		zap.String("reason", exit.Reason))
+	return out.send(&pb.TerminalMessage{Payload: &pb.TerminalMessage_Exit{Exit: exit}})
+}
+
+// streamWriter 将容器输出转换为 data 消息，Send 不是并发安全的，需要加锁
+type streamWriter struct {
+	mu     sync.Mutex
+	stream Stream
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.send(&pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: data}}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *streamWriter) send(msg *pb.TerminalMessage) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.stream.Send(msg)
+}
@@ -0,0 +1,239 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	pb "jos-deployment/api/v1alpha1/pb_pod"
+)
+
+// fakeExecutor 用 run 代替到 API Server 的 exec 连接
+type fakeExecutor struct {
+	run func(ctx context.Context, opts remotecommand.StreamOptions) error
+}
+
+func (e *fakeExecutor) Stream(opts remotecommand.StreamOptions) error {
+	return e.StreamWithContext(context.Background(), opts)
+}
+
+func (e *fakeExecutor) StreamWithContext(ctx context.Context, opts remotecommand.StreamOptions) error {
+	return e.run(ctx, opts)
+}
+
+// fakeStream 客户端消息从 recv 读取，recv 关闭表示客户端结束发送
+type fakeStream struct {
+	recv chan *pb.TerminalMessage
+	mu   sync.Mutex
+	sent []*pb.TerminalMessage
+}
+
+func newFakeStream(msgs ...*pb.TerminalMessage) *fakeStream {
+	s := &fakeStream{recv: make(chan *pb.TerminalMessage, len(msgs))}
+	for _, msg := range msgs {
+		s.recv <- msg
+	}
+	return s
+}
+
+func (s *fakeStream) Recv() (*pb.TerminalMessage, error) {
+	msg, ok := <-s.recv
+	if !ok {
+		return nil, io.EOF
+	}
+	return msg, nil
+}
+
+func (s *fakeStream) Send(msg *pb.TerminalMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// output 返回发送给客户端的输出和结束消息
+func (s *fakeStream) output() (string, *pb.TerminalExit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var data []byte
+	var exit *pb.TerminalExit
+	for _, msg := range s.sent {
+		data = append(data, msg.GetData()...)
+		if msg.GetExit() != nil {
+			exit = msg.GetExit()
+		}
+	}
+	return string(data), exit
+}
+
+func newTestTerminal(t *testing.T, run func(ctx context.Context, opts remotecommand.StreamOptions) error) (*Terminal, *url.URL) {
+	t.Helper()
+	config := &rest.Config{Host: "http://127.0.0.1:1"}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatalf("NewForConfig: %v", err)
+	}
+	execURL := &url.URL{}
+	return &Terminal{
+		Config:    config,
+		Clientset: clientset,
+		NewExecutor: func(_ *rest.Config, _ string, u *url.URL) (remotecommand.Executor, error) {
+			*execURL = *u
+			return &fakeExecutor{run: run}, nil
+		},
+	}, execURL
+}
+
+func sessionInfo(namespace, pod string) *pb.TerminalMessage {
+	return &pb.TerminalMessage{Payload: &pb.TerminalMessage_SessionInfo{SessionInfo: &pb.TerminalSessionInfo{
+		Namespace: namespace,
+		PodName:   pod,
+		Container: "app",
+	}}}
+}
+
+func TestServeRequiresSessionInfoFirst(t *testing.T) {
+	tests := []struct {
+		name  string
+		first *pb.TerminalMessage
+	}{
+		{
+			name:  "data before session info",
+			first: &pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: []byte("ls\n")}},
+		},
+		{
+			name:  "session info without pod",
+			first: sessionInfo("default", ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			term, _ := newTestTerminal(t, func(context.Context, remotecommand.StreamOptions) error {
+				called = true
+				return nil
+			})
+			stream := newFakeStream(tt.first)
+			err := term.Serve(context.Background(), stream, time.Minute)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("Serve() error = %v, want InvalidArgument", err)
+			}
+			if called {
+				t.Fatal("executor must not run without valid session info")
+			}
+		})
+	}
+}
+
+func TestServeForwardsInputAndReportsExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   error
+		wantCode int32
+	}{
+		{name: "normal exit", result: nil, wantCode: 0},
+		{name: "non-zero exit", result: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 3"), Code: 3}, wantCode: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSize *remotecommand.TerminalSize
+			var gotStdin []byte
+			var gotTTY bool
+			term, execURL := newTestTerminal(t, func(_ context.Context, opts remotecommand.StreamOptions) error {
+				gotTTY = opts.Tty
+				gotSize = opts.TerminalSizeQueue.Next()
+				stdin, err := io.ReadAll(opts.Stdin)
+				if err != nil {
+					return err
+				}
+				gotStdin = stdin
+				io.WriteString(opts.Stdout, "hello\n")
+				return tt.result
+			})
+			stream := newFakeStream(
+				sessionInfo("default", "web-0"),
+				&pb.TerminalMessage{Payload: &pb.TerminalMessage_Resize{Resize: &pb.Resize{Width: 120, Height: 40}}},
+				&pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: []byte("ls\n")}},
+				&pb.TerminalMessage{Payload: &pb.TerminalMessage_Data{Data: []byte("exit\n")}},
+			)
+			close(stream.recv)
+
+			if err := term.Serve(context.Background(), stream, time.Minute); err != nil {
+				t.Fatalf("Serve() error = %v", err)
+			}
+			if execURL.Path != "/api/v1/namespaces/default/pods/web-0/exec" || execURL.Query().Get("container") != "app" {
+				t.Errorf("exec url = %s", execURL)
+			}
+			if !gotTTY {
+				t.Error("session must run with a TTY")
+			}
+			if gotSize == nil || gotSize.Width != 120 || gotSize.Height != 40 {
+				t.Errorf("terminal size = %+v, want 120x40", gotSize)
+			}
+			if string(gotStdin) != "ls\nexit\n" {
+				t.Errorf("stdin = %q", gotStdin)
+			}
+			data, exit := stream.output()
+			if data != "hello\n" {
+				t.Errorf("output = %q", data)
+			}
+			if exit == nil || exit.GetExitCode() != tt.wantCode || exit.GetReason() != "" {
+				t.Errorf("exit = %v, want code %d", exit, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServeIdleTimeout(t *testing.T) {
+	term, _ := newTestTerminal(t, func(ctx context.Context, _ remotecommand.StreamOptions) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	// 不关闭 recv，客户端保持连接但不再输入
+	stream := newFakeStream(sessionInfo("default", "web-0"))
+	defer close(stream.recv)
+
+	done := make(chan error, 1)
+	go func() { done <- term.Serve(context.Background(), stream, 50*time.Millisecond) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Serve() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("session did not end after the idle timeout")
+	}
+	_, exit := stream.output()
+	if exit == nil || exit.GetExitCode() != -1 || exit.GetReason() != "idle timeout" {
+		t.Errorf("exit = %v, want idle timeout", exit)
+	}
+}
+
+func TestServeClientDisconnect(t *testing.T) {
+	term, _ := newTestTerminal(t, func(ctx context.Context, _ remotecommand.StreamOptions) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	stream := newFakeStream(sessionInfo("default", "web-0"))
+	defer close(stream.recv)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if err := term.Serve(ctx, stream, time.Minute); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	if _, exit := stream.output(); exit != nil {
+		t.Errorf("exit = %v, want no exit message after disconnect", exit)
+	}
+}
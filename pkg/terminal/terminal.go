@@ -0,0 +1,211 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// DefaultShellCommand 未指定命令时使用，优先 bash，不存在时回退到 sh
+var DefaultShellCommand = []string{
+	"/bin/sh", "-c",
+	"TERM=xterm-256color; export TERM; [ -x /bin/bash ] && exec /bin/bash || exec /bin/sh",
+}
+
+// ExecutorFactory 根据 exec URL 创建 remotecommand.Executor，测试时可替换为假的实现
+type ExecutorFactory func(config *rest.Config, method string, u *url.URL) (remotecommand.Executor, error)
+
+// Options 描述一次 exec 会话的目标容器和命令
+type Options struct {
+	Namespace string
+	PodName   string
+	Container string
+	Command   []string
+	TTY       bool
+}
+
+// Terminal 封装到 Pod 容器的 exec 能力
+type Terminal struct {
+	Config      *rest.Config
+	Clientset   kubernetes.Interface
+	NewExecutor ExecutorFactory
+}
+
+// New 使用集群内配置（或本地 kubeconfig）创建 Terminal
+func New() (*Terminal, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		kubeconfig := filepath.Join(os.Getenv("HOME"), ".kube", "config")
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
+		}
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	return &Terminal{
+		Config:      config,
+		Clientset:   clientset,
+		NewExecutor: DefaultExecutor,
+	}, nil
+}
+
+// DefaultExecutor 优先使用 WebSocket 协议，API Server 不支持时回退到 SPDY
+func DefaultExecutor(config *rest.Config, method string, u *url.URL) (remotecommand.Executor, error) {
+	spdyExec, err := remotecommand.NewSPDYExecutor(config, method, u)
+	if err != nil {
+		return nil, err
+	}
+	wsExec, err := remotecommand.NewWebSocketExecutor(config, "GET", u.String())
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
+
+// Exec 在容器中执行命令，阻塞直到命令结束或 ctx 取消。
+// 命令以非零状态退出时返回其退出码且 err 为 nil；其他失败返回 -1 和错误。
+func (t *Terminal) Exec(ctx context.Context, opts Options, stdin io.Reader, stdout, stderr io.Writer, sizes remotecommand.TerminalSizeQueue) (int, error) {
+	command := opts.Command
+	if len(command) == 0 {
+		command = DefaultShellCommand
+	}
+
+	req := t.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(opts.PodName).
+		Namespace(opts.Namespace).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil && !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+
+	newExecutor := t.NewExecutor
+	if newExecutor == nil {
+		newExecutor = DefaultExecutor
+	}
+	executor, err := newExecutor(t.Config, http.MethodPost, req.URL())
+	if err != nil {
+		return -1, fmt.Errorf("failed to create executor: %w", err)
+	}
+
+	streamOpts := remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Tty:               opts.TTY,
+		TerminalSizeQueue: sizes,
+	}
+	if !opts.TTY {
+		streamOpts.Stderr = stderr
+	}
+	err = executor.StreamWithContext(ctx, streamOpts)
+	if err == nil {
+		return 0, nil
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		return exitErr.ExitStatus(), nil
+	}
+	return -1, err
+}
+
+// HasShell 检查容器中是否存在可用的 shell
+func (t *Terminal) HasShell(ctx context.Context, namespace, podName, container string) (bool, error) {
+	code, err := t.Exec(ctx, Options{
+		Namespace: namespace,
+		PodName:   podName,
+		Container: container,
+		Command:   []string{"/bin/sh", "-c", "exit 0"},
+	}, nil, io.Discard, io.Discard, nil)
+	if err != nil {
+		return false, err
+	}
+	return code == 0, nil
+}
+
+// SizeQueue 实现 remotecommand.TerminalSizeQueue，保存最近一次窗口大小调整
+type SizeQueue struct {
+	ch   chan remotecommand.TerminalSize
+	once sync.Once
+	done chan struct{}
+}
+
+// NewSizeQueue 创建 SizeQueue
+func NewSizeQueue() *SizeQueue {
+	return &SizeQueue{
+		ch:   make(chan remotecommand.TerminalSize, 1),
+		done: make(chan struct{}),
+	}
+}
+
+// Push 提交新的窗口大小，未被消费的旧值会被丢弃
+func (q *SizeQueue) Push(width, height uint16) {
+	size := remotecommand.TerminalSize{Width: width, Height: height}
+	select {
+	case <-q.done:
+		return
+	default:
+	}
+	for {
+		select {
+		case q.ch <- size:
+			return
+		default:
+		}
+		select {
+		case <-q.ch:
+		default:
+		}
+	}
+}
+
+// Next 阻塞直到有新的窗口大小，队列关闭后返回 nil
+func (q *SizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-q.ch:
+		return &size
+	case <-q.done:
+		return nil
+	}
+}
+
+// Close 关闭队列，使 Next 返回 nil
+func (q *SizeQueue) Close() {
+	q.once.Do(func() { close(q.done) })
+}
+
+// WebSocketPattern 浏览器终端 WebSocket 入口在 HTTP mux 上的路由
+const WebSocketPattern = "/prod/v1alpha1/pods/{namespace}/{pod_name}/terminal/ws"
+
+// WebSocketPath 生成指定容器的浏览器终端地址（不含 scheme 和 host）
+func WebSocketPath(namespace, podName, container string) string {
+	p := fmt.Sprintf("/prod/v1alpha1/pods/%s/%s/terminal/ws", url.PathEscape(namespace), url.PathEscape(podName))
+	if container != "" {
+		p += "?" + url.Values{"container": []string{container}}.Encode()
+	}
+	return p
+}